@@ -0,0 +1,292 @@
+package gotau
+
+// Broadcaster implements TAUEvent.
+func (m *FollowMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *StreamUpdateMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *CheerMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster is the broadcaster being raided.
+func (m *RaidMsg) Broadcaster() BroadcasterRef {
+	return BroadcasterRef{
+		BroadcasterID:    m.EventData.ToBroadcasterID,
+		BroadcasterLogin: m.EventData.ToBroadcasterLogin,
+		BroadcasterName:  m.EventData.ToBroadcasterName,
+	}
+}
+
+// Broadcaster is the channel being subscribed to, the legacy format doesn't include the display name.
+func (m *SubscriptionMsg) Broadcaster() BroadcasterRef {
+	return BroadcasterRef{
+		BroadcasterID:    m.EventData.Data.Message.ChannelID,
+		BroadcasterLogin: m.EventData.Data.Message.ChannelName,
+	}
+}
+
+// Broadcaster implements TAUEvent.
+func (m *HypeTrainBeginMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *HypeTrainProgressMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *HypeTrainEndedMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *StreamOnlineMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *StreamOfflineMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PointsRedemptionMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *ChannelSubscribeMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *SubscriptionEndMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *SubscriptionGiftMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *SubscriptionMessageMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PointsRewardAddMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PointsRewardUpdateMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PointsRewardRemoveMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PointsRedemptionUpdateMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PollBeginMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PollProgressMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PollEndMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PredictionBeginMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PredictionProgressMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PredictionLockMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *PredictionEndMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *BanMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *UnbanMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *ModeratorAddMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *ModeratorRemoveMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *GoalBeginMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *GoalProgressMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *GoalEndMsg) Broadcaster() BroadcasterRef {
+	return m.EventData.BroadcasterRef
+}
+
+// Broadcaster implements TAUEvent.
+func (m *CharityCampaignDonateMsg) Broadcaster() BroadcasterRef {
+	return BroadcasterRef{
+		BroadcasterID:    m.EventData.BroadcasterID,
+		BroadcasterLogin: m.EventData.BroadcasterLogin,
+		BroadcasterName:  m.EventData.BroadcasterName,
+	}
+}
+
+// Broadcaster implements TAUEvent.
+func (m *CharityCampaignProgressMsg) Broadcaster() BroadcasterRef {
+	return BroadcasterRef{
+		BroadcasterID:    m.EventData.BroadcasterID,
+		BroadcasterLogin: m.EventData.BroadcasterLogin,
+		BroadcasterName:  m.EventData.BroadcasterName,
+	}
+}
+
+// Actor implements TAUEvent.
+func (m *FollowMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *CheerMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor is the broadcaster doing the raid.
+func (m *RaidMsg) Actor() UserRef {
+	return UserRef{
+		UserID:    m.EventData.FromBroadcasterID,
+		UserLogin: m.EventData.FromBroadcasterLogin,
+		UserName:  m.EventData.FromBroadcasterName,
+	}
+}
+
+// Actor is the subscriber.
+func (m *SubscriptionMsg) Actor() UserRef {
+	return UserRef{
+		UserID:    m.EventData.Data.Message.UserID,
+		UserLogin: m.EventData.Data.Message.UserName,
+		UserName:  m.EventData.Data.Message.DisplayName,
+	}
+}
+
+// Actor is the user who made the last contribution.
+func (m *HypeTrainBeginMsg) Actor() UserRef {
+	return m.EventData.LastContribution.UserRef
+}
+
+// Actor is the user who made the last contribution.
+func (m *HypeTrainProgressMsg) Actor() UserRef {
+	return m.EventData.LastContribution.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *PointsRedemptionMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *ChannelSubscribeMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *SubscriptionEndMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *SubscriptionGiftMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *SubscriptionMessageMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor implements TAUEvent.
+func (m *PointsRedemptionUpdateMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
+
+// Actor is the moderator who banned the user.
+func (m *BanMsg) Actor() UserRef {
+	return UserRef{
+		UserID:    m.EventData.ModeratorID,
+		UserLogin: m.EventData.ModeratorLogin,
+		UserName:  m.EventData.ModeratorName,
+	}
+}
+
+// Actor is the moderator who unbanned the user.
+func (m *UnbanMsg) Actor() UserRef {
+	return UserRef{
+		UserID:    m.EventData.ModeratorID,
+		UserLogin: m.EventData.ModeratorLogin,
+		UserName:  m.EventData.ModeratorName,
+	}
+}
+
+// Actor is empty as twitch doesn't say who added the moderator, the new moderator is the UserRef in EventData.
+func (m *ModeratorAddMsg) Actor() UserRef {
+	return UserRef{}
+}
+
+// Actor is empty as twitch doesn't say who removed the moderator, the removed moderator is the UserRef in EventData.
+func (m *ModeratorRemoveMsg) Actor() UserRef {
+	return UserRef{}
+}
+
+// Actor implements TAUEvent.
+func (m *CharityCampaignDonateMsg) Actor() UserRef {
+	return m.EventData.UserRef
+}
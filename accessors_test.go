@@ -0,0 +1,117 @@
+package gotau
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTAUEvent_Accessors(t *testing.T) {
+	broadcaster := BroadcasterRef{
+		BroadcasterID:    "47073625",
+		BroadcasterLogin: "wwsean08",
+		BroadcasterName:  "WWSean08",
+	}
+	user := UserRef{
+		UserID:    "536397236",
+		UserLogin: "finitesingularity",
+		UserName:  "FiniteSingularity",
+	}
+	tests := []struct {
+		name        string
+		eventType   EventType
+		data        string
+		broadcaster BroadcasterRef
+		actor       UserRef
+	}{
+		{
+			name:        "follow",
+			eventType:   EventFollow,
+			data:        `{"user_id": "536397236", "user_login": "finitesingularity", "user_name": "FiniteSingularity", "broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08"}`,
+			broadcaster: broadcaster,
+			actor:       user,
+		},
+		{
+			name:        "anonymous cheer",
+			eventType:   EventCheer,
+			data:        `{"is_anonymous": true, "user_id": null, "user_login": null, "user_name": null, "broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08", "bits": 100}`,
+			broadcaster: broadcaster,
+		},
+		{
+			name:        "raid",
+			eventType:   EventRaid,
+			data:        `{"from_broadcaster_user_id": "536397236", "from_broadcaster_user_login": "finitesingularity", "from_broadcaster_user_name": "FiniteSingularity", "to_broadcaster_user_id": "47073625", "to_broadcaster_user_login": "wwsean08", "to_broadcaster_user_name": "WWSean08", "viewers": 10}`,
+			broadcaster: broadcaster,
+			actor:       user,
+		},
+		{
+			name:        "ban",
+			eventType:   EventBan,
+			data:        `{"user_id": "12345", "user_login": "spammer", "user_name": "Spammer", "broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08", "moderator_user_id": "536397236", "moderator_user_login": "finitesingularity", "moderator_user_name": "FiniteSingularity", "reason": "spam", "is_permanent": true}`,
+			broadcaster: broadcaster,
+			actor:       user,
+		},
+		{
+			name:        "moderator add",
+			eventType:   EventModeratorAdd,
+			data:        `{"user_id": "536397236", "user_login": "finitesingularity", "user_name": "FiniteSingularity", "broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08"}`,
+			broadcaster: broadcaster,
+		},
+		{
+			name:        "hype train progress",
+			eventType:   EventHypeTrainProgress,
+			data:        `{"broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08", "level": 2, "last_contribution": {"user_id": "536397236", "user_login": "finitesingularity", "user_name": "FiniteSingularity", "type": "bits", "total": 100}}`,
+			broadcaster: broadcaster,
+			actor:       user,
+		},
+		{
+			name:        "stream online",
+			eventType:   EventStreamOnline,
+			data:        `{"id": "9001", "broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08", "type": "live", "started_at": "2021-07-16T04:55:40Z"}`,
+			broadcaster: broadcaster,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := `{"id": null, "event_id": "f8a6b7c9-0000-4d8e-af10-000000000001", "event_type": "` + string(test.eventType) + `", "event_source": "TestCall", "event_data": ` + test.data + `, "created": "2021-07-16T04:55:40.231592+00:00", "origin": "test"}`
+			event, err := decodeEvent(test.eventType, []byte(msg))
+			require.NoError(t, err)
+			require.NotNil(t, event)
+			require.Equal(t, test.eventType, event.Type())
+			require.Equal(t, 2021, event.CreatedAt().Year())
+			require.Equal(t, 55, event.CreatedAt().Minute())
+			require.Equal(t, test.broadcaster, event.Broadcaster())
+			require.Equal(t, test.actor, event.Actor())
+		})
+	}
+}
+
+func TestTAUEvent_HypeTrainContributions(t *testing.T) {
+	msg := `{"id": null, "event_id": "f8a6b7c9-0000-4d8e-af10-000000000002", "event_type": "hype-train-end", "event_source": "TestCall", "event_data": {"broadcaster_user_id": "47073625", "broadcaster_user_login": "wwsean08", "broadcaster_user_name": "WWSean08", "level": 2, "top_contributions": [{"user_id": "536397236", "user_login": "finitesingularity", "user_name": "FiniteSingularity", "type": "subscription", "total": 500}]}, "created": "2021-07-16T04:55:40.231592+00:00", "origin": "test"}`
+	event, err := decodeEvent(EventHypeTrainEnd, []byte(msg))
+	require.NoError(t, err)
+	end, ok := event.(*HypeTrainEndedMsg)
+	require.True(t, ok)
+	require.Equal(t, []Contribution{
+		{
+			UserRef: UserRef{
+				UserID:    "536397236",
+				UserLogin: "finitesingularity",
+				UserName:  "FiniteSingularity",
+			},
+			Type:  "subscription",
+			Total: 500,
+		},
+	}, end.EventData.TopContributions)
+	require.Equal(t, UserRef{}, end.Actor())
+}
+
+func TestTAUEvent_RegisteredEventDefaults(t *testing.T) {
+	event := &Event{
+		EventType: "custom",
+	}
+	require.Equal(t, EventType("custom"), event.Type())
+	require.True(t, event.CreatedAt().IsZero())
+	require.Equal(t, BroadcasterRef{}, event.Broadcaster())
+	require.Equal(t, UserRef{}, event.Actor())
+}
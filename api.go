@@ -1,15 +1,21 @@
 package gotau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"math"
+	"net/url"
 	"strings"
 )
 
 // GetStreamers can be used to get a list of all the streamers that TAU is listening for going live alerts.
 func (c *Client) GetStreamers() ([]*TAUStreamer, error) {
-	body, err := c.apiRequest("streamers", nil, nil, "GET")
+	return c.GetStreamersWithContext(context.Background())
+}
+
+// GetStreamersWithContext is the same as GetStreamers but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetStreamersWithContext(ctx context.Context) ([]*TAUStreamer, error) {
+	body, err := c.apiRequest(ctx, "streamers", nil, nil, "GET")
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +31,12 @@ func (c *Client) GetStreamers() ([]*TAUStreamer, error) {
 
 // GetLatestStreamForStreamer gets the latest stream for a given streamer
 func (c *Client) GetLatestStreamForStreamer(ID string) (*TAUStream, error) {
+	return c.GetLatestStreamForStreamerWithContext(context.Background(), ID)
+}
+
+// GetLatestStreamForStreamerWithContext is the same as GetLatestStreamForStreamer but takes a context to allow for
+// cancellation and deadlines.
+func (c *Client) GetLatestStreamForStreamerWithContext(ctx context.Context, ID string) (*TAUStream, error) {
 	ID = strings.TrimSpace(ID)
 	if ID == "" {
 		return nil, BadRequestError{
@@ -32,7 +44,7 @@ func (c *Client) GetLatestStreamForStreamer(ID string) (*TAUStream, error) {
 		}
 	}
 
-	body, err := c.apiRequest(fmt.Sprintf("streamers/%s/streams/latest", ID), nil, nil, "GET")
+	body, err := c.apiRequest(ctx, fmt.Sprintf("streamers/%s/streams/latest", ID), nil, nil, "GET")
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +60,12 @@ func (c *Client) GetLatestStreamForStreamer(ID string) (*TAUStream, error) {
 
 // FollowStreamerOnTau follows the users and subscribes for notifications when they go live
 func (c *Client) FollowStreamerOnTau(username string) (*TAUStreamer, error) {
+	return c.FollowStreamerOnTauWithContext(context.Background(), username)
+}
+
+// FollowStreamerOnTauWithContext is the same as FollowStreamerOnTau but takes a context to allow for cancellation
+// and deadlines.
+func (c *Client) FollowStreamerOnTauWithContext(ctx context.Context, username string) (*TAUStreamer, error) {
 	type tmp struct {
 		Username  string `json:"twitch_username"`
 		Streaming bool   `json:"streaming"`
@@ -70,7 +88,7 @@ func (c *Client) FollowStreamerOnTau(username string) (*TAUStreamer, error) {
 		return nil, err
 	}
 
-	responseBody, err := c.apiRequest("streamers", nil, body, "POST")
+	responseBody, err := c.apiRequest(ctx, "streamers", nil, body, "POST")
 	if err != nil {
 		return nil, err
 	}
@@ -85,15 +103,61 @@ func (c *Client) FollowStreamerOnTau(username string) (*TAUStreamer, error) {
 
 // GetStreamsForStreamer will get n streams for a streamer.  If maximumStreams is set to -1 then all
 // streams will be gathered.  This may take some time due to pagination.  In the case where there are fewer
-// results than the maximumStreams, those results will be returned.  The number of results you get may be slightly
-// more than maximumResults, based on the pagination of the results.  If you request 0 results, you will get 0 results.
+// results than the maximumStreams, those results will be returned.  If you request 0 results, you will get 0 results.
+// Use IterateStreamsForStreamer to go through a large number of streams without holding them all in memory.
 func (c *Client) GetStreamsForStreamer(streamerID string, maximumStreams int) ([]TAUStream, error) {
-	type tmp struct {
-		Streams  []TAUStream `json:"results"`
-		Previous *string     `json:"previous"`
-		Next     *string     `json:"next"`
-		Count    int         `json:"count"`
+	return c.GetStreamsForStreamerWithContext(context.Background(), streamerID, maximumStreams)
+}
+
+// GetStreamsForStreamerWithContext is the same as GetStreamsForStreamer but takes a context to allow for
+// cancellation and deadlines.
+func (c *Client) GetStreamsForStreamerWithContext(ctx context.Context, streamerID string, maximumStreams int) ([]TAUStream, error) {
+	iterator, err := c.IterateStreamsForStreamer(streamerID, maximumStreams)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TAUStream, 0)
+	for iterator.Next(ctx) {
+		results = append(results, iterator.Page()...)
 	}
+	if iterator.Err() != nil {
+		return nil, iterator.Err()
+	}
+
+	return results, nil
+}
+
+// StreamsIterator goes through the streams for a streamer one page at a time, see IterateStreamsForStreamer.
+//
+//	iterator, err := client.IterateStreamsForStreamer(streamerID, -1)
+//	if err != nil {
+//		return err
+//	}
+//	for iterator.Next(ctx) {
+//		for _, stream := range iterator.Page() {
+//			...
+//		}
+//	}
+//	if iterator.Err() != nil {
+//		return iterator.Err()
+//	}
+type StreamsIterator struct {
+	client   *Client
+	endpoint string
+	params   map[string][]string
+	maximum  int
+	received int
+	page     []TAUStream
+	count    int
+	done     bool
+	err      error
+}
+
+// IterateStreamsForStreamer returns an iterator over the streams for a streamer, only holding one page of streams at a
+// time.  If maximumStreams is -1 then all of the streams will be gone through, otherwise exactly that many are
+// returned unless the streamer has fewer streams.
+func (c *Client) IterateStreamsForStreamer(streamerID string, maximumStreams int) (*StreamsIterator, error) {
 	streamerID = strings.TrimSpace(streamerID)
 	if streamerID == "" {
 		return nil, BadRequestError{
@@ -101,38 +165,90 @@ func (c *Client) GetStreamsForStreamer(streamerID string, maximumStreams int) ([
 		}
 	}
 
-	results := make([]TAUStream, 0)
-	url := fmt.Sprintf("streamers/%s/streams", streamerID)
-	getAll := maximumStreams < 0
-	// if there are more results than that, we've fucked up
-	if getAll {
-		maximumStreams = math.MaxInt64
+	return &StreamsIterator{
+		client:   c,
+		endpoint: fmt.Sprintf("streamers/%s/streams", streamerID),
+		maximum:  maximumStreams,
+	}, nil
+}
+
+// Next fetches the next page of streams, returning false once there are no more streams, the maximum number of
+// streams has been reached or there was an error, which is available from Err.
+func (i *StreamsIterator) Next(ctx context.Context) bool {
+	type tmp struct {
+		Streams  []TAUStream `json:"results"`
+		Previous *string     `json:"previous"`
+		Next     *string     `json:"next"`
+		Count    int         `json:"count"`
+	}
+	i.page = nil
+	if i.done || i.err != nil {
+		return false
+	}
+	if i.maximum >= 0 && i.received >= i.maximum {
+		i.done = true
+		return false
 	}
 
-	count := 0
+	body, err := i.client.apiRequest(ctx, i.endpoint, i.params, nil, "GET")
+	if err != nil {
+		i.err = err
+		return false
+	}
 
-	for count <= maximumStreams {
-		body, err := c.apiRequest(url, nil, nil, "GET")
-		if err != nil {
-			return nil, err
-		}
+	tmpData := new(tmp)
+	err = json.Unmarshal(body, tmpData)
+	if err != nil {
+		i.err = err
+		return false
+	}
+	i.count = tmpData.Count
+
+	page := tmpData.Streams
+	if i.maximum >= 0 && i.received+len(page) > i.maximum {
+		page = page[:i.maximum-i.received]
+	}
+	i.received += len(page)
+	i.page = page
 
-		tmpData := new(tmp)
-		err = json.Unmarshal(body, tmpData)
+	if tmpData.Next == nil {
+		i.done = true
+	} else {
+		i.params, err = nextPageParams(*tmpData.Next)
 		if err != nil {
-			return nil, err
+			i.err = err
+			return false
 		}
-		results = append(results, tmpData.Streams...)
+	}
 
-		// no data left so abort early whether or not we've got enough data
-		if tmpData.Next == nil {
-			break
-		} else {
-			url = *tmpData.Next
-		}
+	return len(page) > 0 || !i.done
+}
 
-		count += len(tmpData.Streams)
-	}
+// Page returns the streams fetched by the last call to Next.
+func (i *StreamsIterator) Page() []TAUStream {
+	return i.page
+}
 
-	return results, nil
+// Err returns the error that stopped the iteration, if there was one.
+func (i *StreamsIterator) Err() error {
+	return i.err
+}
+
+// Count returns the total number of streams TAU has for the streamer, as of the last page fetched.
+func (i *StreamsIterator) Count() int {
+	return i.count
+}
+
+// nextPageParams gets the query parameters from one of TAU's next links.  The link is an absolute url using the host
+// TAU thinks it is on, which may not be reachable from here (for example behind a reverse proxy), so only the query is
+// used with the same endpoint.
+func nextPageParams(next string) (map[string][]string, error) {
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return nil, err
+	}
+	params := nextURL.Query()
+	// apiRequest always adds the format
+	params.Del("format")
+	return params, nil
 }
@@ -1,6 +1,7 @@
 package gotau
 
 import (
+	"bytes"
 	"encoding/json"
 	"strings"
 )
@@ -18,29 +19,133 @@ type TAUStreamer struct {
 
 // TAUStream represents a stream as TAU returns them from it's database.
 type TAUStream struct {
-	ID           string  `json:"id"`
-	StreamID     string  `json:"stream_id"`
-	UserID       string  `json:"user_id"`
-	UserLogin    string  `json:"user_login"`
-	UserName     string  `json:"user_name"`
-	GameID       string  `json:"game_id"`
-	GameName     string  `json:"game_name"`
-	Type         string  `json:"type"`
-	Title        string  `json:"title"`
-	ViewerCount  int     `json:"viewer_count"`
-	StartedAt    *Time   `json:"started_at"`
-	EndedAt      *Time   `json:"ended_at"`
-	Language     string  `json:"language"`
-	ThumbnailUrl string  `json:"thumbnail_url"`
-	TagIDs       TAUTags `json:"tag_ids"`
-	IsMature     bool    `json:"is_mature"`
+	ID           string     `json:"id"`
+	StreamID     string     `json:"stream_id"`
+	UserID       string     `json:"user_id"`
+	UserLogin    string     `json:"user_login"`
+	UserName     string     `json:"user_name"`
+	GameID       string     `json:"game_id"`
+	GameName     string     `json:"game_name"`
+	Type         StreamType `json:"type"`
+	Title        string     `json:"title"`
+	ViewerCount  int        `json:"viewer_count"`
+	StartedAt    *Time      `json:"started_at"`
+	EndedAt      *Time      `json:"ended_at"`
+	Language     string     `json:"language"`
+	ThumbnailUrl string     `json:"thumbnail_url"`
+	TagIDs       TAUTags    `json:"tag_ids"`
+	IsMature     bool       `json:"is_mature"`
+	// tagIDs is tag_ids as TAU sent it, which is written back as is unless TagIDs has been changed since
+	tagIDs json.RawMessage
+}
+
+// UnmarshalJSON keeps tag_ids as TAU sent it, as TagIDs can't tell a null apart from an empty string.
+func (s *TAUStream) UnmarshalJSON(b []byte) error {
+	type stream TAUStream
+	data := struct {
+		*stream
+		TagIDs json.RawMessage `json:"tag_ids"`
+	}{
+		stream: (*stream)(s),
+	}
+	err := json.Unmarshal(b, &data)
+	if err != nil {
+		return err
+	}
+	s.TagIDs = nil
+	s.tagIDs = data.TagIDs
+	if len(data.TagIDs) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data.TagIDs, &s.TagIDs)
+}
+
+// MarshalJSON writes the stream the same way TAU sends it, including tag_ids in whichever form TAU sent it.
+func (s TAUStream) MarshalJSON() ([]byte, error) {
+	tagIDs, err := s.marshalTagIDs()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		ID           string          `json:"id"`
+		StreamID     string          `json:"stream_id"`
+		UserID       string          `json:"user_id"`
+		UserLogin    string          `json:"user_login"`
+		UserName     string          `json:"user_name"`
+		GameID       string          `json:"game_id"`
+		GameName     string          `json:"game_name"`
+		Type         StreamType      `json:"type"`
+		Title        string          `json:"title"`
+		ViewerCount  int             `json:"viewer_count"`
+		StartedAt    *Time           `json:"started_at"`
+		EndedAt      *Time           `json:"ended_at"`
+		Language     string          `json:"language"`
+		ThumbnailUrl string          `json:"thumbnail_url"`
+		TagIDs       json.RawMessage `json:"tag_ids"`
+		IsMature     bool            `json:"is_mature"`
+	}{
+		ID:           s.ID,
+		StreamID:     s.StreamID,
+		UserID:       s.UserID,
+		UserLogin:    s.UserLogin,
+		UserName:     s.UserName,
+		GameID:       s.GameID,
+		GameName:     s.GameName,
+		Type:         s.Type,
+		Title:        s.Title,
+		ViewerCount:  s.ViewerCount,
+		StartedAt:    s.StartedAt,
+		EndedAt:      s.EndedAt,
+		Language:     s.Language,
+		ThumbnailUrl: s.ThumbnailUrl,
+		TagIDs:       tagIDs,
+		IsMature:     s.IsMature,
+	})
+}
+
+// marshalTagIDs returns tag_ids as TAU sent it if TagIDs still holds the same tags, otherwise TagIDs is written in
+// TAU's usual form.
+func (s TAUStream) marshalTagIDs() (json.RawMessage, error) {
+	if s.tagIDs != nil {
+		var sent TAUTags
+		if err := json.Unmarshal(s.tagIDs, &sent); err == nil && sameTags(sent, s.TagIDs) {
+			return s.tagIDs, nil
+		}
+	}
+	return json.Marshal(s.TagIDs)
+}
+
+// sameTags reports whether a and b hold the same tags, treating nil and empty as different.
+func sameTags(a, b TAUTags) bool {
+	if (a == nil) != (b == nil) || len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 //TAUTags is a list of strings containing tags from a stream
 type TAUTags []string
 
-// UnmarshalJSON handles unmarshalling the json data from a less than useful form.
+// UnmarshalJSON handles unmarshalling the json data from a less than useful form, a string containing a python list.
+// A JSON array of strings is accepted too.
 func (t *TAUTags) UnmarshalJSON(b []byte) error {
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '[' {
+		var tags []string
+		err := json.Unmarshal(trimmed, &tags)
+		if err != nil {
+			return err
+		}
+		if tags == nil {
+			tags = []string{}
+		}
+		*t = tags
+		return nil
+	}
 	var stringVal string
 	err := json.Unmarshal(b, &stringVal)
 	if err != nil {
@@ -54,6 +159,23 @@ func (t *TAUTags) UnmarshalJSON(b []byte) error {
 	stringVal = strings.TrimSuffix(stringVal, "]")
 	stringVal = strings.Replace(stringVal, "'", "", -1)
 	stringVal = strings.Replace(stringVal, " ", "", -1)
+	if stringVal == "" {
+		*t = TAUTags{}
+		return nil
+	}
 	*t = strings.Split(stringVal, ",")
 	return nil
 }
+
+// MarshalJSON writes the tags in the form TAU usually sends them, a string containing a python list.  Nil tags are
+// written as null.  TAUStream writes its tags in whichever form TAU sent them.
+func (t TAUTags) MarshalJSON() ([]byte, error) {
+	if t == nil {
+		return []byte("null"), nil
+	}
+	quoted := make([]string, len(t))
+	for i, tag := range t {
+		quoted[i] = "'" + tag + "'"
+	}
+	return json.Marshal("[" + strings.Join(quoted, ", ") + "]")
+}
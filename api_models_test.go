@@ -27,3 +27,69 @@ func TestTAUTags_UnmarshalJSON(t *testing.T) {
 	require.Equal(t, "6ea6bca4-4712-4ab9-a906-e3336a9d8039", data.TagIDs[0])
 	require.Equal(t, "621fb5bf-5498-4d8f-b4ac-db4d40d401bf", data.TagIDs[1])
 }
+
+func TestTAUTags_UnmarshalJSONEmpty(t *testing.T) {
+	type tmp struct {
+		TagIDs TAUTags `json:"tag_ids"`
+	}
+	data := new(tmp)
+	err := json.Unmarshal([]byte("{\"tag_ids\": null}"), data)
+	require.NoError(t, err)
+	require.Nil(t, data.TagIDs)
+
+	data = new(tmp)
+	err = json.Unmarshal([]byte("{\"tag_ids\": \"[]\"}"), data)
+	require.NoError(t, err)
+	require.NotNil(t, data.TagIDs)
+	require.Len(t, data.TagIDs, 0)
+}
+
+func TestTAUTags_UnmarshalJSONArray(t *testing.T) {
+	type tmp struct {
+		TagIDs TAUTags `json:"tag_ids"`
+	}
+	data := new(tmp)
+	err := json.Unmarshal([]byte("{\"tag_ids\": [\"a\", \"b\"]}"), data)
+	require.NoError(t, err)
+	require.Equal(t, TAUTags{"a", "b"}, data.TagIDs)
+
+	data = new(tmp)
+	err = json.Unmarshal([]byte("{\"tag_ids\": []}"), data)
+	require.NoError(t, err)
+	require.NotNil(t, data.TagIDs)
+	require.Len(t, data.TagIDs, 0)
+
+	err = json.Unmarshal([]byte("{\"tag_ids\": [1]}"), data)
+	require.Error(t, err)
+}
+
+func TestTAUStream_MarshalJSONChangedTags(t *testing.T) {
+	stream := new(TAUStream)
+	err := json.Unmarshal([]byte("{\"tag_ids\": \"\"}"), stream)
+	require.NoError(t, err)
+	require.Nil(t, stream.TagIDs)
+
+	stream.TagIDs = TAUTags{"a"}
+	b, err := json.Marshal(stream)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "\"tag_ids\":\"['a']\"")
+}
+
+func TestTAUTags_MarshalJSON(t *testing.T) {
+	type tmp struct {
+		TagIDs TAUTags `json:"tag_ids"`
+	}
+	for _, tagString := range []string{
+		"{\"tag_ids\":\"['6ea6bca4-4712-4ab9-a906-e3336a9d8039']\"}",
+		"{\"tag_ids\":\"['6ea6bca4-4712-4ab9-a906-e3336a9d8039', '621fb5bf-5498-4d8f-b4ac-db4d40d401bf']\"}",
+		"{\"tag_ids\":\"[]\"}",
+		"{\"tag_ids\":null}",
+	} {
+		data := new(tmp)
+		err := json.Unmarshal([]byte(tagString), data)
+		require.NoError(t, err)
+		b, err := json.Marshal(data)
+		require.NoError(t, err)
+		require.Equal(t, tagString, string(b))
+	}
+}
@@ -1,6 +1,8 @@
 package gotau
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/require"
 	"net"
@@ -94,6 +96,36 @@ func TestClient_GetStreamersReturnsError(t *testing.T) {
 	require.IsType(t, GenericError{}, err)
 }
 
+func TestClient_GetStreamersReturnsAuthorizationError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, err := fmt.Fprint(w, "{\"detail\":\"Invalid token.\"}")
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client := Client{
+		hostname: host,
+		port:     portNum,
+		token:    "foo",
+		hasSSL:   false,
+	}
+
+	streamers, err := client.GetStreamers()
+	require.Nil(t, streamers)
+	require.IsType(t, AuthorizationError{}, err)
+	require.True(t, errors.Is(err, AuthorizationError{}))
+	require.Contains(t, err.Error(), "401")
+	require.Contains(t, err.Error(), "Invalid token.")
+	require.Contains(t, err.Error(), "/api/v1/streamers/")
+}
+
 func TestClient_GetLatestStreamForStreamer(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, "/api/v1/streamers/5d8be520-9883-4d09-821a-3c71723e4880/streams/latest/", r.URL.Path)
@@ -128,7 +160,7 @@ func TestClient_GetLatestStreamForStreamer(t *testing.T) {
 	require.Equal(t, "GeekyCleanGaming", stream.UserName)
 	require.Equal(t, "511224", stream.GameID)
 	require.Equal(t, "Apex Legends", stream.GameName)
-	require.Equal(t, "live", stream.Type)
+	require.Equal(t, StreamLive, stream.Type)
 	require.Equal(t, "Grinding Ranked For Funsies 🔫 !support !merch !discord", stream.Title)
 	require.Zero(t, stream.ViewerCount)
 	require.Equal(t, 2021, stream.StartedAt.Year())
@@ -245,7 +277,7 @@ func TestClient_GetStreamsForStreamer(t *testing.T) {
 	require.Equal(t, "4davidblue", streams[0].UserName)
 	require.Equal(t, "509658", streams[0].GameID)
 	require.Equal(t, "Just Chatting", streams[0].GameName)
-	require.Equal(t, "live", streams[0].Type)
+	require.Equal(t, StreamLive, streams[0].Type)
 	require.Equal(t, "IT TAKES TWOsday (w CiaoJordyn) | !donate !gif !freesub !dnd", streams[0].Title)
 	require.Zero(t, streams[0].ViewerCount)
 	require.Equal(t, 2021, streams[0].StartedAt.Year())
@@ -270,3 +302,168 @@ func TestClient_GetStreamsForStreamerReturnsError(t *testing.T) {
 	require.ErrorIs(t, err, BadRequestError{"invalid request, streamer id can't be blank"})
 	require.Nil(t, streams)
 }
+
+func TestClient_GetStreamersWithContextCanceled(t *testing.T) {
+	block := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer ts.Close()
+	defer close(block)
+
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client := Client{
+		hostname: host,
+		port:     portNum,
+		token:    "foo",
+		hasSSL:   false,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	streamers, err := client.GetStreamersWithContext(ctx)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Nil(t, streamers)
+}
+
+// newStreamPagesServer serves total streams in pages of pageSize, with next links pointing at a host that isn't the
+// test server like TAU does when it is behind a proxy.  It returns the server and the pages that were requested.
+func newStreamPagesServer(t *testing.T, total int, pageSize int) (*httptest.Server, *[]string) {
+	var requested []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/streamers/34593db1-1228-40e0-bc3b-2c14b58b1f64/streams/", r.URL.Path)
+		require.Equal(t, "json", r.URL.Query().Get("format"))
+		require.Len(t, r.URL.Query()["format"], 1)
+		page := 1
+		if r.URL.Query().Get("page") != "" {
+			var err error
+			page, err = strconv.Atoi(r.URL.Query().Get("page"))
+			require.NoError(t, err)
+		}
+		requested = append(requested, r.URL.Query().Get("page"))
+
+		results := make([]string, 0)
+		for i := (page - 1) * pageSize; i < page*pageSize && i < total; i++ {
+			results = append(results, fmt.Sprintf("{\"id\":\"stream-%d\",\"type\":\"live\",\"tag_ids\":null}", i))
+		}
+		next := "null"
+		if page*pageSize < total {
+			next = fmt.Sprintf("\"http://tau.internal:8000/api/v1/streamers/34593db1-1228-40e0-bc3b-2c14b58b1f64/streams/?format=json&page=%d\"", page+1)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, "{\"count\":%d,\"next\":%s,\"previous\":null,\"results\":[%s]}", total, next, strings.Join(results, ","))
+		require.NoError(t, err)
+	}))
+
+	return ts, &requested
+}
+
+func newStreamPagesClient(t *testing.T, ts *httptest.Server) *Client {
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return &Client{
+		hostname: host,
+		port:     portNum,
+		token:    "foo",
+		hasSSL:   false,
+	}
+}
+
+func TestClient_GetStreamsForStreamerPagination(t *testing.T) {
+	ts, requested := newStreamPagesServer(t, 5, 2)
+	defer ts.Close()
+	client := newStreamPagesClient(t, ts)
+
+	streams, err := client.GetStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", -1)
+	require.NoError(t, err)
+	require.Len(t, streams, 5)
+	for i, stream := range streams {
+		require.Equal(t, fmt.Sprintf("stream-%d", i), stream.ID)
+	}
+	require.Equal(t, []string{"", "2", "3"}, *requested)
+}
+
+func TestClient_GetStreamsForStreamerMaximum(t *testing.T) {
+	ts, requested := newStreamPagesServer(t, 5, 2)
+	defer ts.Close()
+	client := newStreamPagesClient(t, ts)
+
+	streams, err := client.GetStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", 3)
+	require.NoError(t, err)
+	require.Len(t, streams, 3)
+	require.Equal(t, "stream-2", streams[2].ID)
+	require.Equal(t, []string{"", "2"}, *requested)
+
+	*requested = nil
+	streams, err = client.GetStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", 0)
+	require.NoError(t, err)
+	require.Len(t, streams, 0)
+	require.Empty(t, *requested)
+
+	streams, err = client.GetStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", 10)
+	require.NoError(t, err)
+	require.Len(t, streams, 5)
+}
+
+func TestStreamsIterator(t *testing.T) {
+	ts, requested := newStreamPagesServer(t, 5, 2)
+	defer ts.Close()
+	client := newStreamPagesClient(t, ts)
+
+	iterator, err := client.IterateStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", -1)
+	require.NoError(t, err)
+	var pages [][]TAUStream
+	for iterator.Next(context.Background()) {
+		require.Equal(t, 5, iterator.Count())
+		pages = append(pages, iterator.Page())
+	}
+	require.NoError(t, iterator.Err())
+	require.Len(t, pages, 3)
+	require.Len(t, pages[0], 2)
+	require.Len(t, pages[2], 1)
+	require.Nil(t, iterator.Page())
+	require.False(t, iterator.Next(context.Background()))
+	require.Len(t, *requested, 3)
+}
+
+func TestStreamsIterator_Error(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, "{\"count\":3,\"next\":\"http://tau.internal/api/v1/streamers/34593db1-1228-40e0-bc3b-2c14b58b1f64/streams/?page=2\",\"previous\":null,\"results\":[{\"id\":\"stream-0\"}]}")
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+	client := newStreamPagesClient(t, ts)
+
+	iterator, err := client.IterateStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", -1)
+	require.NoError(t, err)
+	require.True(t, iterator.Next(context.Background()))
+	require.Len(t, iterator.Page(), 1)
+	require.False(t, iterator.Next(context.Background()))
+	require.Error(t, iterator.Err())
+	var genericError GenericError
+	require.ErrorAs(t, iterator.Err(), &genericError)
+	require.Equal(t, http.StatusInternalServerError, genericError.Code)
+
+	streams, err := client.GetStreamsForStreamer("34593db1-1228-40e0-bc3b-2c14b58b1f64", -1)
+	require.Error(t, err)
+	require.Nil(t, streams)
+
+	iterator, err = client.IterateStreamsForStreamer(" ", -1)
+	require.ErrorIs(t, err, BadRequestError{"invalid request, streamer id can't be blank"})
+	require.Nil(t, iterator)
+}
@@ -1,6 +1,6 @@
 package gotau
 
-import "fmt"
+import "time"
 
 // RawCallback is a callback that will be called for every message that is received.  Setting this callback does
 //not prevent other callbacks from being called, though this one will be called first.
@@ -10,6 +10,29 @@ type RawCallback func(msg []byte)
 //and a new client is needed.
 type ErrorCallback func(err error)
 
+// PanicCallback is a callback to handle a panic in any of the other callbacks, handlers or middleware.  The panic is
+// recovered so the client keeps processing messages.
+type PanicCallback func(err PanicError)
+
+// UnknownEventCallback is a callback to handle events with an event type this library doesn't know about yet, with
+// the parsed envelope and the raw message.
+type UnknownEventCallback func(event *Event, msg []byte)
+
+// DecodeErrorCallback is a callback to handle messages that couldn't be parsed, with the error, the event type (empty
+// if the envelope itself couldn't be parsed) and the raw message.
+type DecodeErrorCallback func(err error, eventType EventType, msg []byte)
+
+// ConnectCallback is a callback that is called whenever the client (re)establishes its connection to TAU.
+type ConnectCallback func()
+
+// DisconnectCallback is a callback that is called when the websocket connection to TAU is lost, before any
+// automatic reconnect is attempted.
+type DisconnectCallback func(err error)
+
+// ReconnectCallback is a callback that is called before each automatic reconnect attempt, with the attempt number
+// (starting at 1) and how long the client will wait before making it.
+type ReconnectCallback func(attempt int, delay time.Duration)
+
 // StreamOnlineCallback is a callback to handle the stream coming online event
 type StreamOnlineCallback func(msg *StreamOnlineMsg)
 
@@ -43,106 +66,375 @@ type HypeTrainProgressCallback func(msg *HypeTrainProgressMsg)
 // HypeTrainEndCallback is a callback to handle hype train end events
 type HypeTrainEndCallback func(msg *HypeTrainEndedMsg)
 
+// ChannelSubscribeCallback is a callback to handle new subscription events
+type ChannelSubscribeCallback func(msg *ChannelSubscribeMsg)
+
+// SubscriptionEndCallback is a callback to handle subscription end events
+type SubscriptionEndCallback func(msg *SubscriptionEndMsg)
+
+// SubscriptionGiftCallback is a callback to handle gift subscription events
+type SubscriptionGiftCallback func(msg *SubscriptionGiftMsg)
+
+// SubscriptionMessageCallback is a callback to handle resub message events
+type SubscriptionMessageCallback func(msg *SubscriptionMessageMsg)
+
+// PointsRewardAddCallback is a callback to handle channel points reward add events
+type PointsRewardAddCallback func(msg *PointsRewardAddMsg)
+
+// PointsRewardUpdateCallback is a callback to handle channel points reward update events
+type PointsRewardUpdateCallback func(msg *PointsRewardUpdateMsg)
+
+// PointsRewardRemoveCallback is a callback to handle channel points reward remove events
+type PointsRewardRemoveCallback func(msg *PointsRewardRemoveMsg)
+
+// PointsRedemptionUpdateCallback is a callback to handle points redemption update events
+type PointsRedemptionUpdateCallback func(msg *PointsRedemptionUpdateMsg)
+
+// PollBeginCallback is a callback to handle poll begin events
+type PollBeginCallback func(msg *PollBeginMsg)
+
+// PollProgressCallback is a callback to handle poll progress events
+type PollProgressCallback func(msg *PollProgressMsg)
+
+// PollEndCallback is a callback to handle poll end events
+type PollEndCallback func(msg *PollEndMsg)
+
+// PredictionBeginCallback is a callback to handle prediction begin events
+type PredictionBeginCallback func(msg *PredictionBeginMsg)
+
+// PredictionProgressCallback is a callback to handle prediction progress events
+type PredictionProgressCallback func(msg *PredictionProgressMsg)
+
+// PredictionLockCallback is a callback to handle prediction lock events
+type PredictionLockCallback func(msg *PredictionLockMsg)
+
+// PredictionEndCallback is a callback to handle prediction end events
+type PredictionEndCallback func(msg *PredictionEndMsg)
+
+// BanCallback is a callback to handle ban events
+type BanCallback func(msg *BanMsg)
+
+// UnbanCallback is a callback to handle unban events
+type UnbanCallback func(msg *UnbanMsg)
+
+// ModeratorAddCallback is a callback to handle moderator add events
+type ModeratorAddCallback func(msg *ModeratorAddMsg)
+
+// ModeratorRemoveCallback is a callback to handle moderator remove events
+type ModeratorRemoveCallback func(msg *ModeratorRemoveMsg)
+
+// GoalBeginCallback is a callback to handle goal begin events
+type GoalBeginCallback func(msg *GoalBeginMsg)
+
+// GoalProgressCallback is a callback to handle goal progress events
+type GoalProgressCallback func(msg *GoalProgressMsg)
+
+// GoalEndCallback is a callback to handle goal end events
+type GoalEndCallback func(msg *GoalEndMsg)
+
+// CharityCampaignDonateCallback is a callback to handle charity campaign donation events
+type CharityCampaignDonateCallback func(msg *CharityCampaignDonateMsg)
+
+// CharityCampaignProgressCallback is a callback to handle charity campaign progress events
+type CharityCampaignProgressCallback func(msg *CharityCampaignProgressMsg)
+
 // SetRawCallback sets a callback to be called on all received messages.
 func (c *Client) SetRawCallback(callback RawCallback) {
+	c.callbackLock.Lock()
 	c.rawCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.rawCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetErrorCallback sets a callback for the user to handle errors that might arise (like connection closed)
 func (c *Client) SetErrorCallback(callback ErrorCallback) {
+	c.callbackLock.Lock()
 	c.errorCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.errorCallback)
+	c.callbackLock.Unlock()
+}
+
+// SetPanicCallback sets a callback to be notified when a callback panics, if it isn't set the panic is logged.
+func (c *Client) SetPanicCallback(callback PanicCallback) {
+	c.callbackLock.Lock()
+	c.panicCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetUnknownEventCallback sets a callback to be called when an event with an unknown event type is received.
+func (c *Client) SetUnknownEventCallback(callback UnknownEventCallback) {
+	c.callbackLock.Lock()
+	c.unknownEventCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetDecodeErrorCallback sets a callback to be called when a message can't be parsed.
+func (c *Client) SetDecodeErrorCallback(callback DecodeErrorCallback) {
+	c.callbackLock.Lock()
+	c.decodeErrorCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetConnectCallback sets a callback to be called whenever a connection to TAU is established.
+func (c *Client) SetConnectCallback(callback ConnectCallback) {
+	c.callbackLock.Lock()
+	c.connectCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetDisconnectCallback sets a callback to be called when the connection to TAU is lost.
+func (c *Client) SetDisconnectCallback(callback DisconnectCallback) {
+	c.callbackLock.Lock()
+	c.disconnectCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetReconnectCallback sets a callback to be called before each automatic reconnect attempt.
+func (c *Client) SetReconnectCallback(callback ReconnectCallback) {
+	c.callbackLock.Lock()
+	c.reconnectCallback = callback
+	c.callbackLock.Unlock()
 }
 
 // SetStreamOnlineCallback sets a callback to be called when a stream online event is received.
 func (c *Client) SetStreamOnlineCallback(callback StreamOnlineCallback) {
+	c.callbackLock.Lock()
 	c.streamOnlineCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.streamOnlineCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetStreamOfflineCallback sets a callback to be called when a stream offline event is received.
 func (c *Client) SetStreamOfflineCallback(callback StreamOfflineCallback) {
+	c.callbackLock.Lock()
 	c.streamOfflineCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.streamOfflineCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetFollowCallback sets a callback to be called on a follow event received.
 func (c *Client) SetFollowCallback(callback FollowCallback) {
+	c.callbackLock.Lock()
 	c.followCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.followCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetStreamUpdateCallback sets a callback to be called on stream update events received
 func (c *Client) SetStreamUpdateCallback(callback StreamUpdateCallback) {
+	c.callbackLock.Lock()
 	c.streamUpdateCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.streamUpdateCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetCheerCallback sets a callback to be called when a cheer event is received.
 func (c *Client) SetCheerCallback(callback CheerCallback) {
+	c.callbackLock.Lock()
 	c.cheerCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.cheerCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetRaidCallback sets a callback to be called when a raid event is received.
 func (c *Client) SetRaidCallback(callback RaidCallback) {
+	c.callbackLock.Lock()
 	c.raidCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.raidCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetSubscriptionCallback sets a callback to be called when a subscription event is received.
 func (c *Client) SetSubscriptionCallback(callback SubscriptionCallback) {
+	c.callbackLock.Lock()
 	c.subscriptionCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.subscriptionCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetPointsRedemptionCallback sets a callback to be called when a points redemption event is received.
 func (c *Client) SetPointsRedemptionCallback(callback PointsRedemptionCallback) {
+	c.callbackLock.Lock()
 	c.pointsRedemptionCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.pointsRedemptionCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetHypeTrainBeginCallback sets a callback to be called when a hype train begin event is received.
 func (c *Client) SetHypeTrainBeginCallback(callback HypeTrainBeginCallback) {
+	c.callbackLock.Lock()
 	c.hypeTrainBeginCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.hypeTrainBeginCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetHypeTrainProgressCallback sets a callback to be called when a hype train progress event is received.
 func (c *Client) SetHypeTrainProgressCallback(callback HypeTrainProgressCallback) {
+	c.callbackLock.Lock()
 	c.hypeTrainProgressCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.hypeTrainProgressCallback)
+	c.callbackLock.Unlock()
 }
 
 // SetHypeTrainEndedCallback sets a callback to be called when a hype train ended event is received.
 func (c *Client) SetHypeTrainEndedCallback(callback HypeTrainEndCallback) {
+	c.callbackLock.Lock()
 	c.hypeTrainEndedCallback = callback
-	// Attempt to fix the heisenbug where if I don't acknowledge the callback it will be null
-	// TODO: Figure out an ACTUAL fix
-	_ = fmt.Sprintf("%p", c.hypeTrainEndedCallback)
+	c.callbackLock.Unlock()
+}
+
+// SetChannelSubscribeCallback sets a callback to be called when a new subscription event is received.
+func (c *Client) SetChannelSubscribeCallback(callback ChannelSubscribeCallback) {
+	c.callbackLock.Lock()
+	c.channelSubscribeCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetSubscriptionEndCallback sets a callback to be called when a subscription end event is received.
+func (c *Client) SetSubscriptionEndCallback(callback SubscriptionEndCallback) {
+	c.callbackLock.Lock()
+	c.subscriptionEndCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetSubscriptionGiftCallback sets a callback to be called when a gift subscription event is received.
+func (c *Client) SetSubscriptionGiftCallback(callback SubscriptionGiftCallback) {
+	c.callbackLock.Lock()
+	c.subscriptionGiftCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetSubscriptionMessageCallback sets a callback to be called when a resub message event is received.
+func (c *Client) SetSubscriptionMessageCallback(callback SubscriptionMessageCallback) {
+	c.callbackLock.Lock()
+	c.subscriptionMessageCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPointsRewardAddCallback sets a callback to be called when a channel points reward add event is received.
+func (c *Client) SetPointsRewardAddCallback(callback PointsRewardAddCallback) {
+	c.callbackLock.Lock()
+	c.pointsRewardAddCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPointsRewardUpdateCallback sets a callback to be called when a channel points reward update event is received.
+func (c *Client) SetPointsRewardUpdateCallback(callback PointsRewardUpdateCallback) {
+	c.callbackLock.Lock()
+	c.pointsRewardUpdateCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPointsRewardRemoveCallback sets a callback to be called when a channel points reward remove event is received.
+func (c *Client) SetPointsRewardRemoveCallback(callback PointsRewardRemoveCallback) {
+	c.callbackLock.Lock()
+	c.pointsRewardRemoveCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPointsRedemptionUpdateCallback sets a callback to be called when a points redemption update event is received.
+func (c *Client) SetPointsRedemptionUpdateCallback(callback PointsRedemptionUpdateCallback) {
+	c.callbackLock.Lock()
+	c.pointsRedemptionUpdateCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPollBeginCallback sets a callback to be called when a poll begin event is received.
+func (c *Client) SetPollBeginCallback(callback PollBeginCallback) {
+	c.callbackLock.Lock()
+	c.pollBeginCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPollProgressCallback sets a callback to be called when a poll progress event is received.
+func (c *Client) SetPollProgressCallback(callback PollProgressCallback) {
+	c.callbackLock.Lock()
+	c.pollProgressCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPollEndCallback sets a callback to be called when a poll end event is received.
+func (c *Client) SetPollEndCallback(callback PollEndCallback) {
+	c.callbackLock.Lock()
+	c.pollEndCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPredictionBeginCallback sets a callback to be called when a prediction begin event is received.
+func (c *Client) SetPredictionBeginCallback(callback PredictionBeginCallback) {
+	c.callbackLock.Lock()
+	c.predictionBeginCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPredictionProgressCallback sets a callback to be called when a prediction progress event is received.
+func (c *Client) SetPredictionProgressCallback(callback PredictionProgressCallback) {
+	c.callbackLock.Lock()
+	c.predictionProgressCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPredictionLockCallback sets a callback to be called when a prediction lock event is received.
+func (c *Client) SetPredictionLockCallback(callback PredictionLockCallback) {
+	c.callbackLock.Lock()
+	c.predictionLockCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetPredictionEndCallback sets a callback to be called when a prediction end event is received.
+func (c *Client) SetPredictionEndCallback(callback PredictionEndCallback) {
+	c.callbackLock.Lock()
+	c.predictionEndCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetBanCallback sets a callback to be called when a ban event is received.
+func (c *Client) SetBanCallback(callback BanCallback) {
+	c.callbackLock.Lock()
+	c.banCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetUnbanCallback sets a callback to be called when a unban event is received.
+func (c *Client) SetUnbanCallback(callback UnbanCallback) {
+	c.callbackLock.Lock()
+	c.unbanCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetModeratorAddCallback sets a callback to be called when a moderator add event is received.
+func (c *Client) SetModeratorAddCallback(callback ModeratorAddCallback) {
+	c.callbackLock.Lock()
+	c.moderatorAddCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetModeratorRemoveCallback sets a callback to be called when a moderator remove event is received.
+func (c *Client) SetModeratorRemoveCallback(callback ModeratorRemoveCallback) {
+	c.callbackLock.Lock()
+	c.moderatorRemoveCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetGoalBeginCallback sets a callback to be called when a goal begin event is received.
+func (c *Client) SetGoalBeginCallback(callback GoalBeginCallback) {
+	c.callbackLock.Lock()
+	c.goalBeginCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetGoalProgressCallback sets a callback to be called when a goal progress event is received.
+func (c *Client) SetGoalProgressCallback(callback GoalProgressCallback) {
+	c.callbackLock.Lock()
+	c.goalProgressCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetGoalEndCallback sets a callback to be called when a goal end event is received.
+func (c *Client) SetGoalEndCallback(callback GoalEndCallback) {
+	c.callbackLock.Lock()
+	c.goalEndCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetCharityCampaignDonateCallback sets a callback to be called when a charity campaign donation event is received.
+func (c *Client) SetCharityCampaignDonateCallback(callback CharityCampaignDonateCallback) {
+	c.callbackLock.Lock()
+	c.charityCampaignDonateCallback = callback
+	c.callbackLock.Unlock()
+}
+
+// SetCharityCampaignProgressCallback sets a callback to be called when a charity campaign progress event is received.
+func (c *Client) SetCharityCampaignProgressCallback(callback CharityCampaignProgressCallback) {
+	c.callbackLock.Lock()
+	c.charityCampaignProgressCallback = callback
+	c.callbackLock.Unlock()
 }
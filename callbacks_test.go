@@ -3,6 +3,7 @@ package gotau
 import (
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestClient_SetRawCallback(t *testing.T) {
@@ -104,3 +105,270 @@ func TestClient_SetHypeTrainEndedCallback(t *testing.T) {
 	client.SetHypeTrainEndedCallback(callback)
 	require.NotNil(t, client.hypeTrainEndedCallback)
 }
+
+func TestClient_SetConnectCallback(t *testing.T) {
+	client := Client{}
+	callback := func() {
+	}
+	require.Nil(t, client.connectCallback)
+	client.SetConnectCallback(callback)
+	require.NotNil(t, client.connectCallback)
+}
+
+func TestClient_SetDisconnectCallback(t *testing.T) {
+	client := Client{}
+	callback := func(err error) {
+	}
+	require.Nil(t, client.disconnectCallback)
+	client.SetDisconnectCallback(callback)
+	require.NotNil(t, client.disconnectCallback)
+}
+
+func TestClient_SetReconnectCallback(t *testing.T) {
+	client := Client{}
+	callback := func(attempt int, delay time.Duration) {
+	}
+	require.Nil(t, client.reconnectCallback)
+	client.SetReconnectCallback(callback)
+	require.NotNil(t, client.reconnectCallback)
+}
+
+func TestClient_SetChannelSubscribeCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *ChannelSubscribeMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.channelSubscribeCallback)
+	client.SetChannelSubscribeCallback(callback)
+	require.NotNil(t, client.channelSubscribeCallback)
+}
+
+func TestClient_SetSubscriptionEndCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *SubscriptionEndMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.subscriptionEndCallback)
+	client.SetSubscriptionEndCallback(callback)
+	require.NotNil(t, client.subscriptionEndCallback)
+}
+
+func TestClient_SetSubscriptionGiftCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *SubscriptionGiftMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.subscriptionGiftCallback)
+	client.SetSubscriptionGiftCallback(callback)
+	require.NotNil(t, client.subscriptionGiftCallback)
+}
+
+func TestClient_SetSubscriptionMessageCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *SubscriptionMessageMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.subscriptionMessageCallback)
+	client.SetSubscriptionMessageCallback(callback)
+	require.NotNil(t, client.subscriptionMessageCallback)
+}
+
+func TestClient_SetPointsRewardAddCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PointsRewardAddMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pointsRewardAddCallback)
+	client.SetPointsRewardAddCallback(callback)
+	require.NotNil(t, client.pointsRewardAddCallback)
+}
+
+func TestClient_SetPointsRewardUpdateCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PointsRewardUpdateMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pointsRewardUpdateCallback)
+	client.SetPointsRewardUpdateCallback(callback)
+	require.NotNil(t, client.pointsRewardUpdateCallback)
+}
+
+func TestClient_SetPointsRewardRemoveCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PointsRewardRemoveMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pointsRewardRemoveCallback)
+	client.SetPointsRewardRemoveCallback(callback)
+	require.NotNil(t, client.pointsRewardRemoveCallback)
+}
+
+func TestClient_SetPointsRedemptionUpdateCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PointsRedemptionUpdateMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pointsRedemptionUpdateCallback)
+	client.SetPointsRedemptionUpdateCallback(callback)
+	require.NotNil(t, client.pointsRedemptionUpdateCallback)
+}
+
+func TestClient_SetPollBeginCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PollBeginMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pollBeginCallback)
+	client.SetPollBeginCallback(callback)
+	require.NotNil(t, client.pollBeginCallback)
+}
+
+func TestClient_SetPollProgressCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PollProgressMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pollProgressCallback)
+	client.SetPollProgressCallback(callback)
+	require.NotNil(t, client.pollProgressCallback)
+}
+
+func TestClient_SetPollEndCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PollEndMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.pollEndCallback)
+	client.SetPollEndCallback(callback)
+	require.NotNil(t, client.pollEndCallback)
+}
+
+func TestClient_SetPredictionBeginCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PredictionBeginMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.predictionBeginCallback)
+	client.SetPredictionBeginCallback(callback)
+	require.NotNil(t, client.predictionBeginCallback)
+}
+
+func TestClient_SetPredictionProgressCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PredictionProgressMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.predictionProgressCallback)
+	client.SetPredictionProgressCallback(callback)
+	require.NotNil(t, client.predictionProgressCallback)
+}
+
+func TestClient_SetPredictionLockCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PredictionLockMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.predictionLockCallback)
+	client.SetPredictionLockCallback(callback)
+	require.NotNil(t, client.predictionLockCallback)
+}
+
+func TestClient_SetPredictionEndCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *PredictionEndMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.predictionEndCallback)
+	client.SetPredictionEndCallback(callback)
+	require.NotNil(t, client.predictionEndCallback)
+}
+
+func TestClient_SetBanCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *BanMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.banCallback)
+	client.SetBanCallback(callback)
+	require.NotNil(t, client.banCallback)
+}
+
+func TestClient_SetUnbanCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *UnbanMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.unbanCallback)
+	client.SetUnbanCallback(callback)
+	require.NotNil(t, client.unbanCallback)
+}
+
+func TestClient_SetModeratorAddCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *ModeratorAddMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.moderatorAddCallback)
+	client.SetModeratorAddCallback(callback)
+	require.NotNil(t, client.moderatorAddCallback)
+}
+
+func TestClient_SetModeratorRemoveCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *ModeratorRemoveMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.moderatorRemoveCallback)
+	client.SetModeratorRemoveCallback(callback)
+	require.NotNil(t, client.moderatorRemoveCallback)
+}
+
+func TestClient_SetGoalBeginCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *GoalBeginMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.goalBeginCallback)
+	client.SetGoalBeginCallback(callback)
+	require.NotNil(t, client.goalBeginCallback)
+}
+
+func TestClient_SetGoalProgressCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *GoalProgressMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.goalProgressCallback)
+	client.SetGoalProgressCallback(callback)
+	require.NotNil(t, client.goalProgressCallback)
+}
+
+func TestClient_SetGoalEndCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *GoalEndMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.goalEndCallback)
+	client.SetGoalEndCallback(callback)
+	require.NotNil(t, client.goalEndCallback)
+}
+
+func TestClient_SetCharityCampaignDonateCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *CharityCampaignDonateMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.charityCampaignDonateCallback)
+	client.SetCharityCampaignDonateCallback(callback)
+	require.NotNil(t, client.charityCampaignDonateCallback)
+}
+
+func TestClient_SetCharityCampaignProgressCallback(t *testing.T) {
+	client := Client{}
+	callback := func(msg *CharityCampaignProgressMsg) {
+	}
+	require.NotNil(t, callback)
+	require.Nil(t, client.charityCampaignProgressCallback)
+	client.SetCharityCampaignProgressCallback(callback)
+	require.NotNil(t, client.charityCampaignProgressCallback)
+}
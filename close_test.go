@@ -0,0 +1,231 @@
+package gotau
+
+import (
+	"context"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newCloseTestServer starts a TAU server that sends msg after the login and then reads until the client closes.
+func newCloseTestServer(t *testing.T, msg string, closeCodes chan int) (*httptest.Server, string, int) {
+	upgrader := websocket.Upgrader{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close()
+		_, _, err = c.ReadMessage()
+		require.NoError(t, err)
+		if msg != "" {
+			err = c.WriteMessage(websocket.TextMessage, []byte(msg))
+			require.NoError(t, err)
+		}
+		_, _, err = c.ReadMessage()
+		if closeErr, ok := err.(*websocket.CloseError); ok {
+			closeCodes <- closeErr.Code
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return server, host, portNum
+}
+
+func TestClient_Close(t *testing.T) {
+	closeCodes := make(chan int, 1)
+	server, host, portNum := newCloseTestServer(t, "", closeCodes)
+	defer server.Close()
+
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+	}
+	err := client.Reconnect()
+	require.NoError(t, err)
+	events := client.Events(context.Background())
+
+	err = client.Close()
+	require.NoError(t, err)
+	require.Equal(t, websocket.CloseNormalClosure, <-closeCodes)
+
+	select {
+	case <-client.Done():
+	default:
+		require.Fail(t, "Done should be closed after Close")
+	}
+	_, open := <-events
+	require.False(t, open)
+	require.Equal(t, ErrClientClosed, client.Err())
+	require.Equal(t, ErrClientClosed, client.SendMessage("foo"))
+	require.Equal(t, ErrClientClosed, client.Reconnect())
+	require.NoError(t, client.Close())
+}
+
+func TestClient_ShutdownWaitsForHandlers(t *testing.T) {
+	closeCodes := make(chan int, 1)
+	server, host, portNum := newCloseTestServer(t, fmt.Sprintf(testFollowMsg, "FiniteSingularity"), closeCodes)
+	defer server.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	client := &Client{
+		hostname:           host,
+		port:               portNum,
+		token:              "foo",
+		writeLock:          new(sync.Mutex),
+		parallelProcessing: true,
+		followCallback: func(msg *FollowMsg) {
+			close(started)
+			<-release
+			close(finished)
+		},
+	}
+	err := client.Reconnect()
+	require.NoError(t, err)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = client.Shutdown(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	close(release)
+	<-finished
+	err = client.Shutdown(context.Background())
+	require.NoError(t, err, "the client was already closed")
+}
+
+func TestClient_ShutdownNotConnected(t *testing.T) {
+	client := &Client{
+		writeLock: new(sync.Mutex),
+	}
+	require.NoError(t, client.Shutdown(context.Background()))
+	require.Equal(t, ErrClientClosed, client.SendMessage("foo"))
+}
+
+func TestClient_CloseWithoutEcho(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	release := make(chan struct{})
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close()
+		_, _, err = c.ReadMessage()
+		require.NoError(t, err)
+		// stop reading, so the close message is never echoed
+		<-release
+	}))
+	defer server.Close()
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+	}
+	err = client.Reconnect()
+	require.NoError(t, err)
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- client.Close()
+	}()
+	select {
+	case err = <-closed:
+		require.NoError(t, err)
+	case <-time.After(closeEchoTimeout + 2*time.Second):
+		require.Fail(t, "Close blocked waiting for the close message to be echoed")
+	}
+	require.Equal(t, ErrClientClosed, client.Err())
+}
+
+func TestClient_ShutdownDeadlineRetiresWorkerPool(t *testing.T) {
+	closeCodes := make(chan int, 1)
+	server, host, portNum := newCloseTestServer(t, fmt.Sprintf(testFollowMsg, "FiniteSingularity"), closeCodes)
+	defer server.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+		followCallback: func(msg *FollowMsg) {
+			close(started)
+			<-release
+		},
+	}
+	client.SetWorkerPool(&WorkerPoolConfig{Workers: 1})
+	err := client.Reconnect()
+	require.NoError(t, err)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = client.Shutdown(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+	client.poolLock.RLock()
+	require.Nil(t, client.pool)
+	client.poolLock.RUnlock()
+	close(release)
+}
+
+func TestClient_CloseFromCallback(t *testing.T) {
+	for _, mode := range []string{"sequential", "parallel", "worker pool"} {
+		t.Run(mode, func(t *testing.T) {
+			closeCodes := make(chan int, 1)
+			server, host, portNum := newCloseTestServer(t, fmt.Sprintf(testFollowMsg, "FiniteSingularity"), closeCodes)
+			defer server.Close()
+
+			closed := make(chan error, 1)
+			client := &Client{
+				hostname:           host,
+				port:               portNum,
+				token:              "foo",
+				writeLock:          new(sync.Mutex),
+				parallelProcessing: mode == "parallel",
+			}
+			client.followCallback = func(msg *FollowMsg) {
+				go func() {
+					closed <- client.Close()
+				}()
+			}
+			if mode == "worker pool" {
+				client.SetWorkerPool(&WorkerPoolConfig{Workers: 2, Key: KeyByBroadcaster})
+			}
+			err := client.Reconnect()
+			require.NoError(t, err)
+
+			select {
+			case err = <-closed:
+				require.NoError(t, err)
+			case <-time.After(2 * time.Second):
+				require.Fail(t, "Close blocked when started from a callback")
+			}
+			require.Equal(t, websocket.CloseNormalClosure, <-closeCodes)
+			require.Equal(t, ErrClientClosed, client.Err())
+			require.NoError(t, client.Close())
+		})
+	}
+}
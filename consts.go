@@ -1,15 +1,325 @@
 package gotau
 
+// EventType is the type of an event TAU sends, it is the event_type of every message.  Event types added with
+// RegisterEventType are valid too.
+type EventType string
+
+// The event types TAU sends, see the matching ...Msg type for each.
+const (
+	EventStreamOnline            EventType = "stream-online"
+	EventStreamOffline           EventType = "stream-offline"
+	EventFollow                  EventType = "follow"
+	EventStreamUpdate            EventType = "update"
+	EventCheer                   EventType = "cheer"
+	EventRaid                    EventType = "raid"
+	EventSubscription            EventType = "subscribe"
+	EventPointsRedemption        EventType = "point-redemption"
+	EventHypeTrainBegin          EventType = "hype-train-begin"
+	EventHypeTrainProgress       EventType = "hype-train-progress"
+	EventHypeTrainEnd            EventType = "hype-train-end"
+	EventChannelSubscribe        EventType = "channel-subscribe"
+	EventSubscriptionEnd         EventType = "channel-subscription-end"
+	EventSubscriptionGift        EventType = "channel-subscription-gift"
+	EventSubscriptionMessage     EventType = "channel-subscription-message"
+	EventPointsRewardAdd         EventType = "point-reward-add"
+	EventPointsRewardUpdate      EventType = "point-reward-update"
+	EventPointsRewardRemove      EventType = "point-reward-remove"
+	EventPointsRedemptionUpdate  EventType = "point-redemption-update"
+	EventPollBegin               EventType = "poll-begin"
+	EventPollProgress            EventType = "poll-progress"
+	EventPollEnd                 EventType = "poll-end"
+	EventPredictionBegin         EventType = "prediction-begin"
+	EventPredictionProgress      EventType = "prediction-progress"
+	EventPredictionLock          EventType = "prediction-lock"
+	EventPredictionEnd           EventType = "prediction-end"
+	EventBan                     EventType = "ban"
+	EventUnban                   EventType = "unban"
+	EventModeratorAdd            EventType = "moderator-add"
+	EventModeratorRemove         EventType = "moderator-remove"
+	EventGoalBegin               EventType = "goal-begin"
+	EventGoalProgress            EventType = "goal-progress"
+	EventGoalEnd                 EventType = "goal-end"
+	EventCharityCampaignDonate   EventType = "charity-campaign-donate"
+	EventCharityCampaignProgress EventType = "charity-campaign-progress"
+)
+
+// String returns the event type as TAU sends it.
+func (t EventType) String() string {
+	return string(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t EventType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, unknown event types are kept as is so they can be reported to
+// the UnknownEventCallback.
+func (t *EventType) UnmarshalText(text []byte) error {
+	*t = EventType(text)
+	return nil
+}
+
+// IsValid reports whether the event type is one that can be decoded, either built in or added with
+// RegisterEventType.
+func (t EventType) IsValid() bool {
+	registry.RLock()
+	defer registry.RUnlock()
+	_, ok := registry.factories[t]
+	return ok
+}
+
+// ContributionType is the kind of contribution made to a hype train.
+type ContributionType string
+
+// The contribution types as EventSub sends them, helix sends them in upper case with SUBS for subscriptions, see the
+// helix package for those.
 const (
-	streamOnline     = "stream-online"
-	streamOffline    = "stream-offline"
-	follow           = "follow"
-	update           = "update"
-	cheer            = "cheer"
-	raid             = "raid"
-	subscription     = "subscribe"
-	pointsRedemption = "point-redemption"
-	hypeBegin        = "hype-train-begin"
-	hypeProgress     = "hype-train-progress"
-	hypeEnd          = "hype-train-end"
+	ContributionBits         ContributionType = "bits"
+	ContributionSubscription ContributionType = "subscription"
+	ContributionOther        ContributionType = "other"
 )
+
+// String returns the contribution type.
+func (t ContributionType) String() string {
+	return string(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t ContributionType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the contribution type is kept as twitch sent it so it can be
+// sent back and re-encodes to the same payload.
+func (t *ContributionType) UnmarshalText(text []byte) error {
+	*t = ContributionType(text)
+	return nil
+}
+
+// IsValid reports whether the contribution type is one of the known ones, as either EventSub or helix spells it.
+func (t ContributionType) IsValid() bool {
+	switch t {
+	case ContributionBits, ContributionSubscription, ContributionOther, "BITS", "SUBS", "OTHER":
+		return true
+	}
+	return false
+}
+
+// StreamType is the type of a stream, TAUStream and helix streams are only ever live or blank if there was an error.
+type StreamType string
+
+// The stream types.
+const (
+	StreamLive       StreamType = "live"
+	StreamPlaylist   StreamType = "playlist"
+	StreamWatchParty StreamType = "watch_party"
+	StreamPremiere   StreamType = "premiere"
+	StreamRerun      StreamType = "rerun"
+)
+
+// String returns the stream type.
+func (t StreamType) String() string {
+	return string(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t StreamType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the stream type is kept as twitch sent it.
+func (t *StreamType) UnmarshalText(text []byte) error {
+	*t = StreamType(text)
+	return nil
+}
+
+// IsValid reports whether the stream type is one of the known ones.
+func (t StreamType) IsValid() bool {
+	switch t {
+	case StreamLive, StreamPlaylist, StreamWatchParty, StreamPremiere, StreamRerun:
+		return true
+	}
+	return false
+}
+
+// RedemptionStatus is the status of a channel points redemption.
+type RedemptionStatus string
+
+// The redemption statuses as EventSub sends them, helix uses upper case, see the helix package for those.
+const (
+	RedemptionUnfulfilled RedemptionStatus = "unfulfilled"
+	RedemptionFulfilled   RedemptionStatus = "fulfilled"
+	RedemptionCanceled    RedemptionStatus = "canceled"
+	RedemptionUnknown     RedemptionStatus = "unknown"
+)
+
+// String returns the redemption status.
+func (s RedemptionStatus) String() string {
+	return string(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RedemptionStatus) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the status is kept as twitch sent it so it can be sent back and
+// re-encodes to the same payload.
+func (s *RedemptionStatus) UnmarshalText(text []byte) error {
+	*s = RedemptionStatus(text)
+	return nil
+}
+
+// IsValid reports whether the redemption status is one of the known ones, as either EventSub or helix spells it.
+func (s RedemptionStatus) IsValid() bool {
+	switch s {
+	case RedemptionUnfulfilled, RedemptionFulfilled, RedemptionCanceled, RedemptionUnknown,
+		"UNFULFILLED", "FULFILLED", "CANCELED":
+		return true
+	}
+	return false
+}
+
+// PollStatus is the status of a poll.
+type PollStatus string
+
+// The poll statuses as EventSub sends them when a poll ends, helix uses upper case and has a few more, see the helix
+// package for those.
+const (
+	PollCompleted  PollStatus = "completed"
+	PollArchived   PollStatus = "archived"
+	PollTerminated PollStatus = "terminated"
+)
+
+// String returns the poll status.
+func (s PollStatus) String() string {
+	return string(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PollStatus) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the status is kept as twitch sent it so it can be sent back and
+// re-encodes to the same payload.
+func (s *PollStatus) UnmarshalText(text []byte) error {
+	*s = PollStatus(text)
+	return nil
+}
+
+// IsValid reports whether the poll status is one of the known ones, as either EventSub or helix spells it.
+func (s PollStatus) IsValid() bool {
+	switch s {
+	case PollCompleted, PollArchived, PollTerminated,
+		"ACTIVE", "COMPLETED", "TERMINATED", "ARCHIVED", "MODERATED", "INVALID":
+		return true
+	}
+	return false
+}
+
+// PredictionStatus is the status of a prediction.
+type PredictionStatus string
+
+// The prediction statuses as EventSub sends them when a prediction ends, helix uses upper case and has a few more,
+// see the helix package for those.
+const (
+	PredictionResolved PredictionStatus = "resolved"
+	PredictionCanceled PredictionStatus = "canceled"
+)
+
+// String returns the prediction status.
+func (s PredictionStatus) String() string {
+	return string(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PredictionStatus) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the status is kept as twitch sent it so it can be sent back and
+// re-encodes to the same payload.
+func (s *PredictionStatus) UnmarshalText(text []byte) error {
+	*s = PredictionStatus(text)
+	return nil
+}
+
+// IsValid reports whether the prediction status is one of the known ones, as either EventSub or helix spells it.
+func (s PredictionStatus) IsValid() bool {
+	switch s {
+	case PredictionResolved, PredictionCanceled, "ACTIVE", "RESOLVED", "CANCELED", "LOCKED":
+		return true
+	}
+	return false
+}
+
+// GoalType is what a creator goal is counting.
+type GoalType string
+
+// The goal types.
+const (
+	GoalFollow               GoalType = "follow"
+	GoalSubscription         GoalType = "subscription"
+	GoalSubscriptionCount    GoalType = "subscription_count"
+	GoalNewSubscription      GoalType = "new_subscription"
+	GoalNewSubscriptionCount GoalType = "new_subscription_count"
+)
+
+// String returns the goal type.
+func (t GoalType) String() string {
+	return string(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t GoalType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the goal type is kept as twitch sent it.
+func (t *GoalType) UnmarshalText(text []byte) error {
+	*t = GoalType(text)
+	return nil
+}
+
+// IsValid reports whether the goal type is one of the known ones.
+func (t GoalType) IsValid() bool {
+	switch t {
+	case GoalFollow, GoalSubscription, GoalSubscriptionCount, GoalNewSubscription, GoalNewSubscriptionCount:
+		return true
+	}
+	return false
+}
+
+// SubTier is the tier of a subscription, the legacy subscription format also uses Prime.
+type SubTier string
+
+// The subscription tiers.
+const (
+	SubTier1     SubTier = "1000"
+	SubTier2     SubTier = "2000"
+	SubTier3     SubTier = "3000"
+	SubTierPrime SubTier = "Prime"
+)
+
+// String returns the subscription tier.
+func (t SubTier) String() string {
+	return string(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t SubTier) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the tier is kept as twitch sent it.
+func (t *SubTier) UnmarshalText(text []byte) error {
+	*t = SubTier(text)
+	return nil
+}
+
+// IsValid reports whether the subscription tier is one of the known ones.
+func (t SubTier) IsValid() bool {
+	return t == SubTier1 || t == SubTier2 || t == SubTier3 || t == SubTierPrime
+}
@@ -0,0 +1,91 @@
+package gotau
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventType_IsValid(t *testing.T) {
+	require.True(t, EventFollow.IsValid())
+	require.True(t, EventCharityCampaignProgress.IsValid())
+	require.False(t, EventType("not-a-real-event").IsValid())
+	require.Equal(t, "follow", EventFollow.String())
+}
+
+func TestEventType_UnmarshalUnknown(t *testing.T) {
+	event := new(Event)
+	err := json.Unmarshal([]byte(`{"event_type": "not-a-real-event"}`), event)
+	require.NoError(t, err)
+	require.Equal(t, EventType("not-a-real-event"), event.EventType)
+	require.False(t, event.EventType.IsValid())
+}
+
+func TestEnums_UnmarshalText(t *testing.T) {
+	data := struct {
+		Contributions []ContributionType `json:"contributions"`
+		Streams       []StreamType       `json:"streams"`
+		Statuses      []RedemptionStatus `json:"statuses"`
+		Polls         []PollStatus       `json:"polls"`
+		Predictions   []PredictionStatus `json:"predictions"`
+		Goals         []GoalType         `json:"goals"`
+		Tiers         []SubTier          `json:"tiers"`
+	}{}
+	payload := `{"contributions":["bits","BITS","SUBS","subscription","OTHER","new"],"streams":["live","rerun",""],` +
+		`"statuses":["FULFILLED","canceled","unfilled"],"polls":["completed","ACTIVE","open"],` +
+		`"predictions":["resolved","LOCKED","paused"],"goals":["follow","new_subscription_count","follower"],` +
+		`"tiers":["1000","2000","3000","Prime","prime"]}`
+	err := json.Unmarshal([]byte(payload), &data)
+	require.NoError(t, err)
+	// values are kept as twitch sent them
+	require.Equal(t, []ContributionType{ContributionBits, "BITS", "SUBS", ContributionSubscription, "OTHER", "new"},
+		data.Contributions)
+	require.Equal(t, []StreamType{StreamLive, StreamRerun, StreamType("")}, data.Streams)
+	require.Equal(t, []RedemptionStatus{"FULFILLED", RedemptionCanceled, "unfilled"}, data.Statuses)
+	require.Equal(t, []PollStatus{PollCompleted, "ACTIVE", "open"}, data.Polls)
+	require.Equal(t, []PredictionStatus{PredictionResolved, "LOCKED", "paused"}, data.Predictions)
+	require.Equal(t, []GoalType{GoalFollow, GoalNewSubscriptionCount, "follower"}, data.Goals)
+	require.Equal(t, []SubTier{SubTier1, SubTier2, SubTier3, SubTierPrime, "prime"}, data.Tiers)
+
+	for _, contribution := range data.Contributions[:5] {
+		require.True(t, contribution.IsValid())
+	}
+	require.False(t, data.Contributions[5].IsValid())
+	require.False(t, ContributionType("subs").IsValid())
+	require.False(t, data.Streams[2].IsValid())
+	require.True(t, data.Statuses[0].IsValid())
+	require.False(t, data.Statuses[2].IsValid())
+	require.True(t, data.Polls[1].IsValid())
+	require.False(t, data.Polls[2].IsValid())
+	require.True(t, data.Predictions[1].IsValid())
+	require.False(t, data.Predictions[2].IsValid())
+	require.True(t, data.Goals[1].IsValid())
+	require.False(t, data.Goals[2].IsValid())
+	require.False(t, StreamType("LIVE").IsValid())
+	require.True(t, data.Tiers[3].IsValid())
+	require.False(t, data.Tiers[4].IsValid())
+
+	b, err := json.Marshal(data)
+	require.NoError(t, err)
+	require.Equal(t, payload, string(b))
+}
+
+func TestEnums_MarshalText(t *testing.T) {
+	data := struct {
+		EventType    EventType        `json:"event_type"`
+		Contribution ContributionType `json:"contribution"`
+		Stream       StreamType       `json:"stream"`
+		Status       RedemptionStatus `json:"status"`
+		Tier         SubTier          `json:"tier"`
+	}{
+		EventType:    EventHypeTrainBegin,
+		Contribution: ContributionSubscription,
+		Stream:       StreamLive,
+		Status:       RedemptionUnfulfilled,
+		Tier:         SubTier2,
+	}
+	b, err := json.Marshal(data)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"event_type": "hype-train-begin", "contribution": "subscription", "stream": "live", "status": "unfulfilled", "tier": "2000"}`, string(b))
+}
@@ -1,14 +1,29 @@
 package gotau
 
+import (
+	"errors"
+	"fmt"
+)
+
 // AuthorizationError represents an Unauthorized response from Twitch
 type AuthorizationError struct {
 	Err string
 }
 
 func (a AuthorizationError) Error() string {
+	if a.Err == "" {
+		return "unauthorized"
+	}
 	return a.Err
 }
 
+// Is reports whether target is an AuthorizationError, whatever its message, so errors.Is(err, AuthorizationError{})
+// matches any unauthorized response.
+func (a AuthorizationError) Is(target error) bool {
+	_, ok := target.(AuthorizationError)
+	return ok
+}
+
 // BadRequestError represents bad inputs from an application trying to make an API request to
 //twitch based on their documented limitations
 type BadRequestError struct {
@@ -29,3 +44,37 @@ type GenericError struct {
 func (g GenericError) Error() string {
 	return g.Err
 }
+
+// ErrNotConnected is returned when trying to send a message while the websocket isn't connected, for example while
+// a reconnect is in progress.
+var ErrNotConnected = errors.New("websocket is not connected")
+
+// ErrPongTimeout is passed to the DisconnectCallback (or ErrorCallback) when keepalive is enabled and TAU stopped
+// responding, meaning the connection was silently lost.
+var ErrPongTimeout = errors.New("no response from TAU before the keepalive deadline")
+
+// ErrClientClosed is returned when using a client after Close or Shutdown has been called.
+var ErrClientClosed = errors.New("client is closed")
+
+// ErrBlankEventType is returned by RegisterEventType when the event type is blank.
+var ErrBlankEventType = errors.New("event type can't be blank")
+
+// ErrNilEventFactory is returned by RegisterEventType when the factory is nil.
+var ErrNilEventFactory = errors.New("event factory can't be nil")
+
+// ErrBuiltinEventType is returned by RegisterEventType when trying to replace one of the built in event types.
+var ErrBuiltinEventType = errors.New("event type is built in and can't be replaced")
+
+// PanicError is passed to the PanicCallback when a callback, handler or middleware panics while handling a message.
+type PanicError struct {
+	// Value is what was passed to panic
+	Value interface{}
+	// Stack is the stack trace of the goroutine that panicked
+	Stack []byte
+	// Msg is the raw message that was being handled, it is nil for the connection callbacks
+	Msg []byte
+}
+
+func (p PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic: %v", p.Value)
+}
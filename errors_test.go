@@ -1,6 +1,7 @@
 package gotau
 
 import (
+	"errors"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -14,6 +15,16 @@ func TestAuthorizationError_Error(t *testing.T) {
 	require.Equal(t, "User unauthorized", err.Error())
 }
 
+func TestAuthorizationError_Is(t *testing.T) {
+	err := error(AuthorizationError{
+		Err: "User unauthorized",
+	})
+
+	require.True(t, errors.Is(err, AuthorizationError{}))
+	require.False(t, errors.Is(err, GenericError{}))
+	require.Equal(t, "unauthorized", AuthorizationError{}.Error())
+}
+
 func TestBadRequestError_Error(t *testing.T) {
 	err := BadRequestError{
 		Err: "Malformed request",
@@ -0,0 +1,219 @@
+package gotau
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TAUEvent is implemented by every message type TAU sends (FollowMsg, CheerMsg, RaidMsg, etc.), it is what is sent
+// over the channel returned by Events.  The methods allow for handling any event the same way, such as logging who did
+// what in which channel, use a type switch to get at the rest of a specific message.
+type TAUEvent interface {
+	// Type is the event type, the same as Event.EventType.  It isn't called EventType because every message embeds
+	// *Event, whose EventType field would clash with a method of the same name.
+	Type() EventType
+	// CreatedAt is when TAU received the event.
+	CreatedAt() time.Time
+	// Broadcaster is the channel the event happened in.
+	Broadcaster() BroadcasterRef
+	// Actor is the user who caused the event, it is empty for events that weren't caused by a user and for anonymous
+	// cheers and gifts.
+	Actor() UserRef
+	isTAUEvent()
+}
+
+// isTAUEvent seals TAUEvent to the message types, all of which embed *Event, including the ones added with
+// RegisterEventType.
+func (e *Event) isTAUEvent() {}
+
+// envelope returns the embedded envelope, so it can be replaced with the one the middleware passed on.
+func (e *Event) envelope() *Event {
+	return e
+}
+
+// Type returns EventType.
+func (e *Event) Type() EventType {
+	if e == nil {
+		return ""
+	}
+	return e.EventType
+}
+
+// CreatedAt returns Created as a time.Time.
+func (e *Event) CreatedAt() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.Created.Time
+}
+
+// Broadcaster returns an empty BroadcasterRef, it is overridden by the messages that have a broadcaster.  Event types
+// added with RegisterEventType can do the same.
+func (e *Event) Broadcaster() BroadcasterRef {
+	return BroadcasterRef{}
+}
+
+// Actor returns an empty UserRef, it is overridden by the messages for events caused by a user.  Event types added
+// with RegisterEventType can do the same.
+func (e *Event) Actor() UserRef {
+	return UserRef{}
+}
+
+// OverflowPolicy decides what happens when an event stream's buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits for the receiver to make room, which will hold up processing of further messages.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest throws away the oldest buffered event to make room for the new one.
+	OverflowDropOldest
+	// OverflowDropNewest throws away the new event, keeping what is already buffered.
+	OverflowDropNewest
+)
+
+// defaultEventBufferSize is the buffer size used by Events.
+const defaultEventBufferSize = 100
+
+// EventStreamConfig configures the channel returned by EventsWithConfig.
+type EventStreamConfig struct {
+	// BufferSize is the capacity of the channel, 0 makes it unbuffered.
+	BufferSize int
+	// Overflow is what to do when the buffer is full, defaults to OverflowBlock.
+	Overflow OverflowPolicy
+}
+
+type eventStream struct {
+	ch       chan TAUEvent
+	overflow OverflowPolicy
+	done     <-chan struct{}
+	// closed is closed when the client shuts down, it ends the stream the same as done
+	closed    chan struct{}
+	closeOnce sync.Once
+	// sendLock serializes sends, so drop oldest doesn't race with itself when messages are processed in parallel, and
+	// guards ch being closed once finished is set.  A blocked send gives up when done or closed is, which is also
+	// what leads to the channel being closed, so it is never held for long by then.
+	sendLock sync.Mutex
+	finished bool
+}
+
+// Events returns a channel that receives every event from TAU, in addition to any callbacks that are set.  The
+// channel is buffered to hold 100 events and blocks when it is full, use EventsWithConfig to change that.  The channel
+// is closed once ctx is done or the client is closed.
+func (c *Client) Events(ctx context.Context) <-chan TAUEvent {
+	return c.EventsWithConfig(ctx, EventStreamConfig{
+		BufferSize: defaultEventBufferSize,
+		Overflow:   OverflowBlock,
+	})
+}
+
+// EventsWithConfig is the same as Events but allows for configuring the buffering and overflow policy of the channel.
+func (c *Client) EventsWithConfig(ctx context.Context, config EventStreamConfig) <-chan TAUEvent {
+	bufferSize := config.BufferSize
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+	stream := &eventStream{
+		ch:       make(chan TAUEvent, bufferSize),
+		overflow: config.Overflow,
+		done:     ctx.Done(),
+		closed:   make(chan struct{}),
+	}
+
+	c.streamLock.Lock()
+	if c.Err() != nil {
+		// the client has been shut down so there will be no more events
+		c.streamLock.Unlock()
+		close(stream.ch)
+		return stream.ch
+	}
+	if c.streams == nil {
+		c.streams = make(map[*eventStream]struct{})
+	}
+	c.streams[stream] = struct{}{}
+	c.streamLock.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stream.closed:
+		}
+		c.streamLock.Lock()
+		delete(c.streams, stream)
+		c.streamLock.Unlock()
+		stream.finish()
+	}()
+
+	return stream.ch
+}
+
+// publish sends the event to all of the open event streams.  The streams are sent to without holding streamLock, so a
+// blocked stream doesn't stop others from being removed or the client from closing them.
+func (c *Client) publish(event TAUEvent) {
+	for _, stream := range c.openStreams() {
+		stream.send(event)
+	}
+}
+
+func (c *Client) openStreams() []*eventStream {
+	c.streamLock.RLock()
+	defer c.streamLock.RUnlock()
+	streams := make([]*eventStream, 0, len(c.streams))
+	for stream := range c.streams {
+		streams = append(streams, stream)
+	}
+	return streams
+}
+
+func (s *eventStream) send(event TAUEvent) {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	if s.finished {
+		return
+	}
+	switch s.overflow {
+	case OverflowDropNewest:
+		select {
+		case s.ch <- event:
+		default:
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.ch <- event:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+			default:
+				// unbuffered with nobody waiting, nothing to drop so drop this one
+				return
+			}
+		}
+	default:
+		select {
+		case s.ch <- event:
+		case <-s.done:
+		case <-s.closed:
+		}
+	}
+}
+
+// finish closes the channel once any send in progress has given up.
+func (s *eventStream) finish() {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	s.finished = true
+	close(s.ch)
+}
+
+// closeStreams ends all of the event streams, events already buffered can still be received before the channels are
+// closed.
+func (c *Client) closeStreams() {
+	for _, stream := range c.openStreams() {
+		stream.closeOnce.Do(func() {
+			close(stream.closed)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package gotau
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const testFollowMsg = "{\"id\":null,\"event_id\":\"69db248a-b980-4de6-ad06-b528feb1294e\",\"event_type\":\"follow\",\"event_source\":\"TestCall\",\"event_data\":{\"user_name\":\"%s\",\"user_id\":\"\",\"user_login\":\"finitesingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_name\":\"wwsean08\",\"broadcaster_user_login\":\"wwsean08\"},\"created\":\"2021-05-22T05:16:21.506340+00:00\",\"origin\":\"test\"}"
+
+func TestClient_Events(t *testing.T) {
+	client := Client{}
+	followCalled := false
+	client.followCallback = func(msg *FollowMsg) {
+		followCalled = true
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	events := client.Events(ctx)
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	client.handleMessage([]byte("{\"event_type\":\"unknown\"}"))
+
+	event := <-events
+	followMsg, ok := event.(*FollowMsg)
+	require.True(t, ok)
+	require.Equal(t, "FiniteSingularity", followMsg.EventData.UserName)
+	require.True(t, followCalled, "callbacks should still be called")
+
+	cancel()
+	select {
+	case _, open := <-events:
+		require.False(t, open, "only the follow event should have been sent")
+	case <-time.After(time.Second):
+		require.Fail(t, "channel was not closed after the context was canceled")
+	}
+}
+
+func TestClient_EventsWithConfigOverflow(t *testing.T) {
+	type testData struct {
+		overflow OverflowPolicy
+		expected []string
+	}
+	data := []testData{
+		{
+			overflow: OverflowDropOldest,
+			expected: []string{"two", "three"},
+		},
+		{
+			overflow: OverflowDropNewest,
+			expected: []string{"one", "two"},
+		},
+	}
+
+	for _, item := range data {
+		client := Client{}
+		ctx, cancel := context.WithCancel(context.Background())
+		events := client.EventsWithConfig(ctx, EventStreamConfig{
+			BufferSize: 2,
+			Overflow:   item.overflow,
+		})
+		for _, name := range []string{"one", "two", "three"} {
+			client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, name)))
+		}
+		cancel()
+
+		var received []string
+		for event := range events {
+			received = append(received, event.(*FollowMsg).EventData.UserName)
+		}
+		require.Equal(t, item.expected, received)
+	}
+}
+
+func TestClient_EventsBlockUnblocksOnCancel(t *testing.T) {
+	client := Client{}
+	ctx, cancel := context.WithCancel(context.Background())
+	_ = client.EventsWithConfig(ctx, EventStreamConfig{
+		BufferSize: 0,
+		Overflow:   OverflowBlock,
+	})
+
+	handled := make(chan struct{})
+	go func() {
+		client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+		close(handled)
+	}()
+
+	select {
+	case <-handled:
+		require.Fail(t, "handleMessage should block while nobody is receiving")
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		require.Fail(t, "handleMessage still blocked after the context was canceled")
+	}
+}
+
+func TestClient_EventsBlockedStreamDoesNotBlockClose(t *testing.T) {
+	client := Client{}
+	_ = client.EventsWithConfig(context.Background(), EventStreamConfig{
+		BufferSize: 0,
+		Overflow:   OverflowBlock,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	other := client.EventsWithConfig(ctx, EventStreamConfig{
+		BufferSize: 10,
+		Overflow:   OverflowBlock,
+	})
+
+	handled := make(chan struct{})
+	go func() {
+		client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+		close(handled)
+	}()
+	select {
+	case <-handled:
+		require.Fail(t, "handleMessage should block while nobody is receiving")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// removing the canceled stream must not wait for the blocked send
+	cancel()
+	timeout := time.After(time.Second)
+	for open := true; open; {
+		select {
+		case _, open = <-other:
+		case <-timeout:
+			require.FailNow(t, "canceled stream was not closed while another stream was blocked")
+		}
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		client.closeStreams()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		require.Fail(t, "closeStreams blocked behind the stuck send")
+	}
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		require.Fail(t, "handleMessage still blocked after the streams were closed")
+	}
+}
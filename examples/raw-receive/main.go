@@ -4,8 +4,8 @@ import (
 	"fmt"
 	tau "github.com/Team-TAU/tau-client-go"
 	"os"
+	"os/signal"
 	"strconv"
-	"time"
 )
 
 // This application gets all messages from TAU and prints them to stdout
@@ -24,9 +24,14 @@ func main() {
 
 	client.SetRawCallback(rawCallback)
 
-	for {
-		time.Sleep(time.Minute)
+	// run until interrupted, then disconnect cleanly
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	select {
+	case <-signals:
+	case <-client.Done():
 	}
+	_ = client.Close()
 }
 
 func rawCallback(msg []byte) {
@@ -3,8 +3,8 @@ package main
 import (
 	tau "github.com/Team-TAU/tau-client-go"
 	"os"
+	"os/signal"
 	"strconv"
-	"time"
 )
 
 func main() {
@@ -24,9 +24,14 @@ func main() {
 	client.SetSubscriptionCallback(onSub)
 	client.SetCheerCallback(onCheer)
 
-	for {
-		time.Sleep(time.Minute)
+	// run until interrupted, then disconnect cleanly
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	select {
+	case <-signals:
+	case <-client.Done():
 	}
+	_ = client.Close()
 }
 
 func onFollow(msg *tau.FollowMsg) {
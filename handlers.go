@@ -0,0 +1,303 @@
+package gotau
+
+import "sync"
+
+// Unsubscribe removes a handler that was registered with one of the On... functions, it is safe to call more than
+// once.  Handlers are called after the matching Set... callback, in the order they were registered.
+type Unsubscribe func()
+
+type eventHandler struct {
+	id uint64
+	fn func(event TAUEvent)
+}
+
+// on registers fn to be called for every event of eventType.  The handler slices are never modified in place so
+// dispatch can keep using the one it read while handlers are added or removed.
+func (c *Client) on(eventType EventType, fn func(event TAUEvent)) Unsubscribe {
+	c.callbackLock.Lock()
+	defer c.callbackLock.Unlock()
+	if c.handlers == nil {
+		c.handlers = make(map[EventType][]eventHandler)
+	}
+	c.nextHandlerID++
+	id := c.nextHandlerID
+	current := c.handlers[eventType]
+	handlers := make([]eventHandler, len(current), len(current)+1)
+	copy(handlers, current)
+	c.handlers[eventType] = append(handlers, eventHandler{id: id, fn: fn})
+
+	once := new(sync.Once)
+	return func() {
+		once.Do(func() {
+			c.off(eventType, id)
+		})
+	}
+}
+
+func (c *Client) off(eventType EventType, id uint64) {
+	c.callbackLock.Lock()
+	defer c.callbackLock.Unlock()
+	current := c.handlers[eventType]
+	handlers := make([]eventHandler, 0, len(current))
+	for _, handler := range current {
+		if handler.id != id {
+			handlers = append(handlers, handler)
+		}
+	}
+	if len(handlers) == 0 {
+		delete(c.handlers, eventType)
+		return
+	}
+	c.handlers[eventType] = handlers
+}
+
+// On registers a handler for every event with the given event type, including ones added with RegisterEventType.  Call
+// the returned function to remove it.
+func (c *Client) On(eventType EventType, handler func(event TAUEvent)) Unsubscribe {
+	return c.on(eventType, handler)
+}
+
+// OnStreamOnline registers a handler for stream online events, in addition to any others.
+func (c *Client) OnStreamOnline(handler StreamOnlineCallback) Unsubscribe {
+	return c.on(EventStreamOnline, func(event TAUEvent) {
+		handler(event.(*StreamOnlineMsg))
+	})
+}
+
+// OnStreamOffline registers a handler for stream offline events, in addition to any others.
+func (c *Client) OnStreamOffline(handler StreamOfflineCallback) Unsubscribe {
+	return c.on(EventStreamOffline, func(event TAUEvent) {
+		handler(event.(*StreamOfflineMsg))
+	})
+}
+
+// OnFollow registers a handler for follow events, in addition to any others.
+func (c *Client) OnFollow(handler FollowCallback) Unsubscribe {
+	return c.on(EventFollow, func(event TAUEvent) {
+		handler(event.(*FollowMsg))
+	})
+}
+
+// OnStreamUpdate registers a handler for stream update events, in addition to any others.
+func (c *Client) OnStreamUpdate(handler StreamUpdateCallback) Unsubscribe {
+	return c.on(EventStreamUpdate, func(event TAUEvent) {
+		handler(event.(*StreamUpdateMsg))
+	})
+}
+
+// OnCheer registers a handler for cheer events, in addition to any others.
+func (c *Client) OnCheer(handler CheerCallback) Unsubscribe {
+	return c.on(EventCheer, func(event TAUEvent) {
+		handler(event.(*CheerMsg))
+	})
+}
+
+// OnRaid registers a handler for raid events, in addition to any others.
+func (c *Client) OnRaid(handler RaidCallback) Unsubscribe {
+	return c.on(EventRaid, func(event TAUEvent) {
+		handler(event.(*RaidMsg))
+	})
+}
+
+// OnSubscription registers a handler for subscription events, in addition to any others.
+func (c *Client) OnSubscription(handler SubscriptionCallback) Unsubscribe {
+	return c.on(EventSubscription, func(event TAUEvent) {
+		handler(event.(*SubscriptionMsg))
+	})
+}
+
+// OnPointsRedemption registers a handler for points redemption events, in addition to any others.
+func (c *Client) OnPointsRedemption(handler PointsRedemptionCallback) Unsubscribe {
+	return c.on(EventPointsRedemption, func(event TAUEvent) {
+		handler(event.(*PointsRedemptionMsg))
+	})
+}
+
+// OnHypeTrainBegin registers a handler for hype train begin events, in addition to any others.
+func (c *Client) OnHypeTrainBegin(handler HypeTrainBeginCallback) Unsubscribe {
+	return c.on(EventHypeTrainBegin, func(event TAUEvent) {
+		handler(event.(*HypeTrainBeginMsg))
+	})
+}
+
+// OnHypeTrainProgress registers a handler for hype train progress events, in addition to any others.
+func (c *Client) OnHypeTrainProgress(handler HypeTrainProgressCallback) Unsubscribe {
+	return c.on(EventHypeTrainProgress, func(event TAUEvent) {
+		handler(event.(*HypeTrainProgressMsg))
+	})
+}
+
+// OnHypeTrainEnd registers a handler for hype train end events, in addition to any others.
+func (c *Client) OnHypeTrainEnd(handler HypeTrainEndCallback) Unsubscribe {
+	return c.on(EventHypeTrainEnd, func(event TAUEvent) {
+		handler(event.(*HypeTrainEndedMsg))
+	})
+}
+
+// OnChannelSubscribe registers a handler for new subscription events, in addition to any others.
+func (c *Client) OnChannelSubscribe(handler ChannelSubscribeCallback) Unsubscribe {
+	return c.on(EventChannelSubscribe, func(event TAUEvent) {
+		handler(event.(*ChannelSubscribeMsg))
+	})
+}
+
+// OnSubscriptionEnd registers a handler for subscription end events, in addition to any others.
+func (c *Client) OnSubscriptionEnd(handler SubscriptionEndCallback) Unsubscribe {
+	return c.on(EventSubscriptionEnd, func(event TAUEvent) {
+		handler(event.(*SubscriptionEndMsg))
+	})
+}
+
+// OnSubscriptionGift registers a handler for gift subscription events, in addition to any others.
+func (c *Client) OnSubscriptionGift(handler SubscriptionGiftCallback) Unsubscribe {
+	return c.on(EventSubscriptionGift, func(event TAUEvent) {
+		handler(event.(*SubscriptionGiftMsg))
+	})
+}
+
+// OnSubscriptionMessage registers a handler for resub message events, in addition to any others.
+func (c *Client) OnSubscriptionMessage(handler SubscriptionMessageCallback) Unsubscribe {
+	return c.on(EventSubscriptionMessage, func(event TAUEvent) {
+		handler(event.(*SubscriptionMessageMsg))
+	})
+}
+
+// OnPointsRewardAdd registers a handler for channel points reward add events, in addition to any others.
+func (c *Client) OnPointsRewardAdd(handler PointsRewardAddCallback) Unsubscribe {
+	return c.on(EventPointsRewardAdd, func(event TAUEvent) {
+		handler(event.(*PointsRewardAddMsg))
+	})
+}
+
+// OnPointsRewardUpdate registers a handler for channel points reward update events, in addition to any others.
+func (c *Client) OnPointsRewardUpdate(handler PointsRewardUpdateCallback) Unsubscribe {
+	return c.on(EventPointsRewardUpdate, func(event TAUEvent) {
+		handler(event.(*PointsRewardUpdateMsg))
+	})
+}
+
+// OnPointsRewardRemove registers a handler for channel points reward remove events, in addition to any others.
+func (c *Client) OnPointsRewardRemove(handler PointsRewardRemoveCallback) Unsubscribe {
+	return c.on(EventPointsRewardRemove, func(event TAUEvent) {
+		handler(event.(*PointsRewardRemoveMsg))
+	})
+}
+
+// OnPointsRedemptionUpdate registers a handler for points redemption update events, in addition to any others.
+func (c *Client) OnPointsRedemptionUpdate(handler PointsRedemptionUpdateCallback) Unsubscribe {
+	return c.on(EventPointsRedemptionUpdate, func(event TAUEvent) {
+		handler(event.(*PointsRedemptionUpdateMsg))
+	})
+}
+
+// OnPollBegin registers a handler for poll begin events, in addition to any others.
+func (c *Client) OnPollBegin(handler PollBeginCallback) Unsubscribe {
+	return c.on(EventPollBegin, func(event TAUEvent) {
+		handler(event.(*PollBeginMsg))
+	})
+}
+
+// OnPollProgress registers a handler for poll progress events, in addition to any others.
+func (c *Client) OnPollProgress(handler PollProgressCallback) Unsubscribe {
+	return c.on(EventPollProgress, func(event TAUEvent) {
+		handler(event.(*PollProgressMsg))
+	})
+}
+
+// OnPollEnd registers a handler for poll end events, in addition to any others.
+func (c *Client) OnPollEnd(handler PollEndCallback) Unsubscribe {
+	return c.on(EventPollEnd, func(event TAUEvent) {
+		handler(event.(*PollEndMsg))
+	})
+}
+
+// OnPredictionBegin registers a handler for prediction begin events, in addition to any others.
+func (c *Client) OnPredictionBegin(handler PredictionBeginCallback) Unsubscribe {
+	return c.on(EventPredictionBegin, func(event TAUEvent) {
+		handler(event.(*PredictionBeginMsg))
+	})
+}
+
+// OnPredictionProgress registers a handler for prediction progress events, in addition to any others.
+func (c *Client) OnPredictionProgress(handler PredictionProgressCallback) Unsubscribe {
+	return c.on(EventPredictionProgress, func(event TAUEvent) {
+		handler(event.(*PredictionProgressMsg))
+	})
+}
+
+// OnPredictionLock registers a handler for prediction lock events, in addition to any others.
+func (c *Client) OnPredictionLock(handler PredictionLockCallback) Unsubscribe {
+	return c.on(EventPredictionLock, func(event TAUEvent) {
+		handler(event.(*PredictionLockMsg))
+	})
+}
+
+// OnPredictionEnd registers a handler for prediction end events, in addition to any others.
+func (c *Client) OnPredictionEnd(handler PredictionEndCallback) Unsubscribe {
+	return c.on(EventPredictionEnd, func(event TAUEvent) {
+		handler(event.(*PredictionEndMsg))
+	})
+}
+
+// OnBan registers a handler for ban events, in addition to any others.
+func (c *Client) OnBan(handler BanCallback) Unsubscribe {
+	return c.on(EventBan, func(event TAUEvent) {
+		handler(event.(*BanMsg))
+	})
+}
+
+// OnUnban registers a handler for unban events, in addition to any others.
+func (c *Client) OnUnban(handler UnbanCallback) Unsubscribe {
+	return c.on(EventUnban, func(event TAUEvent) {
+		handler(event.(*UnbanMsg))
+	})
+}
+
+// OnModeratorAdd registers a handler for moderator add events, in addition to any others.
+func (c *Client) OnModeratorAdd(handler ModeratorAddCallback) Unsubscribe {
+	return c.on(EventModeratorAdd, func(event TAUEvent) {
+		handler(event.(*ModeratorAddMsg))
+	})
+}
+
+// OnModeratorRemove registers a handler for moderator remove events, in addition to any others.
+func (c *Client) OnModeratorRemove(handler ModeratorRemoveCallback) Unsubscribe {
+	return c.on(EventModeratorRemove, func(event TAUEvent) {
+		handler(event.(*ModeratorRemoveMsg))
+	})
+}
+
+// OnGoalBegin registers a handler for goal begin events, in addition to any others.
+func (c *Client) OnGoalBegin(handler GoalBeginCallback) Unsubscribe {
+	return c.on(EventGoalBegin, func(event TAUEvent) {
+		handler(event.(*GoalBeginMsg))
+	})
+}
+
+// OnGoalProgress registers a handler for goal progress events, in addition to any others.
+func (c *Client) OnGoalProgress(handler GoalProgressCallback) Unsubscribe {
+	return c.on(EventGoalProgress, func(event TAUEvent) {
+		handler(event.(*GoalProgressMsg))
+	})
+}
+
+// OnGoalEnd registers a handler for goal end events, in addition to any others.
+func (c *Client) OnGoalEnd(handler GoalEndCallback) Unsubscribe {
+	return c.on(EventGoalEnd, func(event TAUEvent) {
+		handler(event.(*GoalEndMsg))
+	})
+}
+
+// OnCharityCampaignDonate registers a handler for charity campaign donation events, in addition to any others.
+func (c *Client) OnCharityCampaignDonate(handler CharityCampaignDonateCallback) Unsubscribe {
+	return c.on(EventCharityCampaignDonate, func(event TAUEvent) {
+		handler(event.(*CharityCampaignDonateMsg))
+	})
+}
+
+// OnCharityCampaignProgress registers a handler for charity campaign progress events, in addition to any others.
+func (c *Client) OnCharityCampaignProgress(handler CharityCampaignProgressCallback) Unsubscribe {
+	return c.on(EventCharityCampaignProgress, func(event TAUEvent) {
+		handler(event.(*CharityCampaignProgressMsg))
+	})
+}
@@ -0,0 +1,67 @@
+package gotau
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+func TestClient_OnFollowMultipleHandlers(t *testing.T) {
+	client := Client{}
+	var calls []string
+	client.SetFollowCallback(func(msg *FollowMsg) {
+		calls = append(calls, "callback")
+	})
+	unsubscribeAlerts := client.OnFollow(func(msg *FollowMsg) {
+		calls = append(calls, "alerts")
+	})
+	client.OnFollow(func(msg *FollowMsg) {
+		require.Equal(t, "FiniteSingularity", msg.EventData.UserName)
+		calls = append(calls, "stats")
+	})
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.Equal(t, []string{"callback", "alerts", "stats"}, calls)
+
+	calls = nil
+	unsubscribeAlerts()
+	unsubscribeAlerts()
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.Equal(t, []string{"callback", "stats"}, calls)
+}
+
+func TestClient_OnFollowUnsubscribeFromHandler(t *testing.T) {
+	client := Client{}
+	called := 0
+	var unsubscribe Unsubscribe
+	unsubscribe = client.OnFollow(func(msg *FollowMsg) {
+		called++
+		unsubscribe()
+	})
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.Equal(t, 1, called)
+}
+
+// Meant to be run with -race, registering handlers while messages are being handled shouldn't race.
+func TestClient_OnConcurrentWithHandleMessage(t *testing.T) {
+	client := Client{}
+	wg := new(sync.WaitGroup)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unsubscribe := client.OnFollow(func(msg *FollowMsg) {})
+			client.SetFollowCallback(func(msg *FollowMsg) {})
+			unsubscribe()
+		}()
+		go func() {
+			defer wg.Done()
+			client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+		}()
+	}
+	wg.Wait()
+	require.Empty(t, client.handlers)
+}
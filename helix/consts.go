@@ -0,0 +1,37 @@
+package helix
+
+import (
+	gotau "github.com/Team-TAU/tau-client-go"
+)
+
+// The contribution types as helix sends them, the gotau constants are the EventSub spelling.
+const (
+	ContributionBits         gotau.ContributionType = "BITS"
+	ContributionSubscription gotau.ContributionType = "SUBS"
+	ContributionOther        gotau.ContributionType = "OTHER"
+)
+
+// The redemption statuses as helix sends them, the gotau constants are the EventSub spelling.
+const (
+	RedemptionUnfulfilled gotau.RedemptionStatus = "UNFULFILLED"
+	RedemptionFulfilled   gotau.RedemptionStatus = "FULFILLED"
+	RedemptionCanceled    gotau.RedemptionStatus = "CANCELED"
+)
+
+// The poll statuses as helix sends them, the gotau constants are the EventSub spelling.
+const (
+	PollActive     gotau.PollStatus = "ACTIVE"
+	PollCompleted  gotau.PollStatus = "COMPLETED"
+	PollTerminated gotau.PollStatus = "TERMINATED"
+	PollArchived   gotau.PollStatus = "ARCHIVED"
+	PollModerated  gotau.PollStatus = "MODERATED"
+	PollInvalid    gotau.PollStatus = "INVALID"
+)
+
+// The prediction statuses as helix sends them, the gotau constants are the EventSub spelling.
+const (
+	PredictionActive   gotau.PredictionStatus = "ACTIVE"
+	PredictionResolved gotau.PredictionStatus = "RESOLVED"
+	PredictionCanceled gotau.PredictionStatus = "CANCELED"
+	PredictionLocked   gotau.PredictionStatus = "LOCKED"
+)
@@ -1,6 +1,7 @@
 package helix
 
 import (
+	"context"
 	"fmt"
 	gotau "github.com/Team-TAU/tau-client-go"
 	"strings"
@@ -8,7 +9,12 @@ import (
 
 // DeleteRequest runs a raw GET request against the twitch helix pass thru, and returns true if the delete worked.
 func (c *Client) DeleteRequest(endpoint string, params map[string][]string) (bool, error) {
-	_, err := c.helixRequest(endpoint, params, nil, "DELETE")
+	return c.DeleteRequestWithContext(context.Background(), endpoint, params)
+}
+
+// DeleteRequestWithContext is the same as DeleteRequest but takes a context to allow for cancellation and deadlines.
+func (c *Client) DeleteRequestWithContext(ctx context.Context, endpoint string, params map[string][]string) (bool, error) {
+	_, err := c.helixRequest(ctx, endpoint, params, nil, "DELETE")
 	if err != nil {
 		return false, err
 	}
@@ -17,6 +23,11 @@ func (c *Client) DeleteRequest(endpoint string, params map[string][]string) (boo
 
 // DeleteCustomReward makes an api call to https://dev.twitch.tv/docs/api/reference#delete-custom-reward, and formats the data.
 func (c *Client) DeleteCustomReward(broadcasterID, ID string) (bool, error) {
+	return c.DeleteCustomRewardWithContext(context.Background(), broadcasterID, ID)
+}
+
+// DeleteCustomRewardWithContext is the same as DeleteCustomReward but takes a context to allow for cancellation and deadlines.
+func (c *Client) DeleteCustomRewardWithContext(ctx context.Context, broadcasterID, ID string) (bool, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	ID = strings.TrimSpace(ID)
 	if broadcasterID == "" {
@@ -35,11 +46,16 @@ func (c *Client) DeleteCustomReward(broadcasterID, ID string) (bool, error) {
 		"id":             {ID},
 	}
 
-	return c.DeleteRequest("channel_points/custom_rewards", params)
+	return c.DeleteRequestWithContext(ctx, "channel_points/custom_rewards", params)
 }
 
 // DeleteEventSubSubscription makes an api call to https://dev.twitch.tv/docs/api/reference#delete-eventsub-subscription, and formats the data.
 func (c *Client) DeleteEventSubSubscription(ID string) (bool, error) {
+	return c.DeleteEventSubSubscriptionWithContext(context.Background(), ID)
+}
+
+// DeleteEventSubSubscriptionWithContext is the same as DeleteEventSubSubscription but takes a context to allow for cancellation and deadlines.
+func (c *Client) DeleteEventSubSubscriptionWithContext(ctx context.Context, ID string) (bool, error) {
 	ID = strings.TrimSpace(ID)
 	if ID == "" {
 		return false, gotau.BadRequestError{
@@ -51,11 +67,16 @@ func (c *Client) DeleteEventSubSubscription(ID string) (bool, error) {
 		"id": {ID},
 	}
 
-	return c.DeleteRequest("eventsub/subscriptions", params)
+	return c.DeleteRequestWithContext(ctx, "eventsub/subscriptions", params)
 }
 
 // DeleteUserFollows makes an api call to https://dev.twitch.tv/docs/api/reference#delete-user-follows, and formats the data.
 func (c *Client) DeleteUserFollows(fromID, toID string) (bool, error) {
+	return c.DeleteUserFollowsWithContext(context.Background(), fromID, toID)
+}
+
+// DeleteUserFollowsWithContext is the same as DeleteUserFollows but takes a context to allow for cancellation and deadlines.
+func (c *Client) DeleteUserFollowsWithContext(ctx context.Context, fromID, toID string) (bool, error) {
 	fromID = strings.TrimSpace(fromID)
 	toID = strings.TrimSpace(toID)
 
@@ -75,11 +96,16 @@ func (c *Client) DeleteUserFollows(fromID, toID string) (bool, error) {
 		"to_id":   {toID},
 	}
 
-	return c.DeleteRequest("users/follows", params)
+	return c.DeleteRequestWithContext(ctx, "users/follows", params)
 }
 
 // UnblockUser makes an api call to https://dev.twitch.tv/docs/api/reference#unblock-user, and formats the data.
 func (c *Client) UnblockUser(userID string) (bool, error) {
+	return c.UnblockUserWithContext(context.Background(), userID)
+}
+
+// UnblockUserWithContext is the same as UnblockUser but takes a context to allow for cancellation and deadlines.
+func (c *Client) UnblockUserWithContext(ctx context.Context, userID string) (bool, error) {
 	userID = strings.TrimSpace(userID)
 	if userID == "" {
 		return false, gotau.BadRequestError{
@@ -91,11 +117,16 @@ func (c *Client) UnblockUser(userID string) (bool, error) {
 		"user_id": {userID},
 	}
 
-	return c.DeleteRequest("users/blocks", params)
+	return c.DeleteRequestWithContext(ctx, "users/blocks", params)
 }
 
 // DeleteVideos makes an api call to https://dev.twitch.tv/docs/api/reference#delete-videos, and formats the data.
 func (c *Client) DeleteVideos(IDs []string) (bool, error) {
+	return c.DeleteVideosWithContext(context.Background(), IDs)
+}
+
+// DeleteVideosWithContext is the same as DeleteVideos but takes a context to allow for cancellation and deadlines.
+func (c *Client) DeleteVideosWithContext(ctx context.Context, IDs []string) (bool, error) {
 	if len(IDs) == 0 {
 		return false, gotau.BadRequestError{
 			Err: "invalid request, IDs can't be empty",
@@ -111,11 +142,16 @@ func (c *Client) DeleteVideos(IDs []string) (bool, error) {
 		"id": IDs,
 	}
 
-	return c.DeleteRequest("videos", params)
+	return c.DeleteRequestWithContext(ctx, "videos", params)
 }
 
 // DeleteChannelStreamScheduleSegment makes an api call to https://dev.twitch.tv/docs/api/reference#delete-channel-stream-schedule-segment, and formats the data.
 func (c *Client) DeleteChannelStreamScheduleSegment(broadcasterID, ID string) (bool, error) {
+	return c.DeleteChannelStreamScheduleSegmentWithContext(context.Background(), broadcasterID, ID)
+}
+
+// DeleteChannelStreamScheduleSegmentWithContext is the same as DeleteChannelStreamScheduleSegment but takes a context to allow for cancellation and deadlines.
+func (c *Client) DeleteChannelStreamScheduleSegmentWithContext(ctx context.Context, broadcasterID, ID string) (bool, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	ID = strings.TrimSpace(ID)
 
@@ -137,5 +173,5 @@ func (c *Client) DeleteChannelStreamScheduleSegment(broadcasterID, ID string) (b
 		"id":             {ID},
 	}
 
-	return c.DeleteRequest("schedule/segment", params)
+	return c.DeleteRequestWithContext(ctx, "schedule/segment", params)
 }
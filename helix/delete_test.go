@@ -57,7 +57,9 @@ func TestClient_DeleteRequestReturnsAuthError(t *testing.T) {
 
 	deleted, err := client.DeleteRequest("channel_points/custom_rewards", nil)
 	require.Error(t, err)
-	require.IsType(t, gotau.AuthorizationError{}, err)
+	require.IsType(t, APIError{}, err)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
 	require.False(t, deleted)
 }
 
@@ -114,8 +116,9 @@ func TestClient_DeleteRequestReturnsGenericError(t *testing.T) {
 
 	deleted, err := client.DeleteRequest("channel_points/custom_rewards", nil)
 	require.Error(t, err)
-	genericError, ok := err.(gotau.GenericError)
-	require.True(t, ok)
+	require.ErrorIs(t, err, ErrNotFound)
+	genericError := gotau.GenericError{}
+	require.ErrorAs(t, err, &genericError)
 	require.False(t, deleted)
 
 	require.Equal(t, 404, genericError.Code)
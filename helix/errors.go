@@ -1,11 +1,115 @@
 package helix
 
-import "time"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	gotau "github.com/Team-TAU/tau-client-go"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for the response codes twitch documents, an APIError (or RateLimitError) with the matching status
+// satisfies errors.Is, for example errors.Is(err, helix.ErrNotFound).
+var (
+	ErrBadRequest          = errors.New("bad request")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("conflict")
+	ErrUnprocessableEntity = errors.New("unprocessable entity")
+	ErrTooManyRequests     = errors.New("too many requests")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:          ErrBadRequest,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusConflict:            ErrConflict,
+	http.StatusUnprocessableEntity: ErrUnprocessableEntity,
+	http.StatusTooManyRequests:     ErrTooManyRequests,
+}
+
+// APIError is a non 2xx response from twitch, decoded from the {"error","status","message"} body it sends along with
+// it, see https://dev.twitch.tv/docs/api/guide#twitch-api-errors
+type APIError struct {
+	// Status is the http status code of the response.
+	Status int `json:"status"`
+	// Err is twitch's name for the status, e.g. "Unauthorized".
+	Err string `json:"error"`
+	// Message says what went wrong, e.g. "Invalid OAuth token".
+	Message string `json:"message"`
+	// URL is the url of the request, including the query.
+	URL string `json:"-"`
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+}
+
+// newAPIError decodes the body of a failed response.  Bodies that aren't twitch's format, like the errors TAU
+// returns itself, are kept as the message.
+func newAPIError(status int, url string, body []byte) APIError {
+	apiErr := APIError{}
+	decoded := struct {
+		APIError
+		// Detail is where TAU puts its own errors, e.g. when its token is invalid.
+		Detail string `json:"detail"`
+	}{}
+	if json.Unmarshal(body, &decoded) == nil {
+		apiErr = decoded.APIError
+		if apiErr.Message == "" {
+			apiErr.Message = decoded.Detail
+		}
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	apiErr.Status = status
+	if apiErr.Err == "" {
+		apiErr.Err = http.StatusText(status)
+	}
+	apiErr.URL = url
+	apiErr.Body = body
+	return apiErr
+}
+
+func (a APIError) Error() string {
+	msg := fmt.Sprintf("twitch responded %d %s", a.Status, a.Err)
+	if a.Message != "" {
+		msg += ": " + a.Message
+	}
+	if a.URL != "" {
+		msg += fmt.Sprintf(" (%s)", a.URL)
+	}
+	return msg
+}
+
+// Is reports whether target is the sentinel error for the status, e.g. ErrUnauthorized for a 401.
+func (a APIError) Is(target error) bool {
+	sentinel, ok := statusErrors[a.Status]
+	return ok && sentinel == target
+}
+
+// Unwrap returns the error used before APIError was introduced, a gotau.AuthorizationError for a 401 or a
+// gotau.GenericError otherwise, so existing errors.Is and errors.As checks keep working.
+func (a APIError) Unwrap() error {
+	if a.Status == http.StatusUnauthorized {
+		return gotau.AuthorizationError{
+			Err: a.Error(),
+		}
+	}
+	return gotau.GenericError{
+		Err:  fmt.Sprintf("response code %d: %s", a.Status, a.Body),
+		Body: a.Body,
+		Code: a.Status,
+	}
+}
 
 // RateLimitError occurs when a twitch rate limits the request.
 type RateLimitError struct {
 	err   string
 	reset *time.Time
+	api   *APIError
 }
 
 func (r RateLimitError) Error() string {
@@ -17,3 +121,24 @@ func (r RateLimitError) Error() string {
 func (r RateLimitError) ResetTime() *time.Time {
 	return r.reset
 }
+
+// Is reports whether target is ErrTooManyRequests.
+func (r RateLimitError) Is(target error) bool {
+	return target == ErrTooManyRequests
+}
+
+// Unwrap returns the APIError decoded from twitch's 429 response, it's nil when the rate limit scheduler gave up
+// without sending the request.
+func (r RateLimitError) Unwrap() error {
+	if r.api == nil {
+		return nil
+	}
+	return *r.api
+}
+
+// ErrRepeatedCursor is returned by the pagers when twitch hands back a cursor that has already been requested, paging
+// any further would loop forever.
+var ErrRepeatedCursor = errors.New("pagination cursor repeated")
+
+// ErrStopPaging can be returned from a page callback to stop paging early, the pager then returns nil.
+var ErrStopPaging = errors.New("stop paging")
@@ -1,7 +1,15 @@
 package helix
 
 import (
+	"errors"
+	"fmt"
+	gotau "github.com/Team-TAU/tau-client-go"
 	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -24,3 +32,120 @@ func TestRateLimitError_ResetTime(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, now, *err.ResetTime())
 }
+
+func TestNewAPIError(t *testing.T) {
+	body := []byte(`{"error":"Unauthorized","status":401,"message":"Invalid OAuth token"}`)
+	err := newAPIError(http.StatusUnauthorized, "https://tau.example.com/api/twitch/helix/users/?id=1", body)
+	require.Equal(t, APIError{
+		Status:  401,
+		Err:     "Unauthorized",
+		Message: "Invalid OAuth token",
+		URL:     "https://tau.example.com/api/twitch/helix/users/?id=1",
+		Body:    body,
+	}, err)
+	require.Equal(t, "twitch responded 401 Unauthorized: Invalid OAuth token (https://tau.example.com/api/twitch/helix/users/?id=1)", err.Error())
+
+	err = newAPIError(http.StatusUnauthorized, "", []byte(`{"detail":"Invalid token."}`))
+	require.Equal(t, "Unauthorized", err.Err)
+	require.Equal(t, "Invalid token.", err.Message)
+	require.Equal(t, "twitch responded 401 Unauthorized: Invalid token.", err.Error())
+
+	err = newAPIError(http.StatusBadGateway, "", []byte("<html>bad gateway</html>\n"))
+	require.Equal(t, "Bad Gateway", err.Err)
+	require.Equal(t, "<html>bad gateway</html>", err.Message)
+
+	err = newAPIError(http.StatusNotFound, "", nil)
+	require.Equal(t, "twitch responded 404 Not Found", err.Error())
+}
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		status   int
+		sentinel error
+	}{
+		{http.StatusBadRequest, ErrBadRequest},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrUnprocessableEntity},
+		{http.StatusTooManyRequests, ErrTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sentinel.Error(), func(t *testing.T) {
+			var err error = newAPIError(tt.status, "", nil)
+			require.ErrorIs(t, err, tt.sentinel)
+			wrapped := fmt.Errorf("wrapped: %w", err)
+			require.ErrorIs(t, wrapped, tt.sentinel)
+			apiErr := APIError{}
+			require.ErrorAs(t, wrapped, &apiErr)
+			require.Equal(t, tt.status, apiErr.Status)
+		})
+	}
+
+	var err error = newAPIError(http.StatusInternalServerError, "", nil)
+	for _, tt := range tests {
+		require.False(t, errors.Is(err, tt.sentinel))
+	}
+}
+
+func TestAPIError_Unwrap(t *testing.T) {
+	var err error = newAPIError(http.StatusUnauthorized, "", []byte(`{"message":"Invalid OAuth token"}`))
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
+
+	err = newAPIError(http.StatusForbidden, "", []byte("missing scope"))
+	genericErr := gotau.GenericError{}
+	require.ErrorAs(t, err, &genericErr)
+	require.Equal(t, http.StatusForbidden, genericErr.Code)
+	require.Equal(t, []byte("missing scope"), genericErr.Body)
+	require.Equal(t, "response code 403: missing scope", genericErr.Err)
+}
+
+func TestRateLimitError_Unwrap(t *testing.T) {
+	var err error = RateLimitError{err: "this is a test"}
+	require.ErrorIs(t, err, ErrTooManyRequests)
+	require.Nil(t, errors.Unwrap(err))
+
+	apiErr := newAPIError(http.StatusTooManyRequests, "", []byte(`{"message":"slow down"}`))
+	err = RateLimitError{err: "this is a test", api: &apiErr}
+	require.ErrorIs(t, err, ErrTooManyRequests)
+	unwrapped := APIError{}
+	require.ErrorAs(t, err, &unwrapped)
+	require.Equal(t, "slow down", unwrapped.Message)
+}
+
+func TestClient_RequestReturnsAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") == "slow" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = fmt.Fprint(w, `{"error":"Too Many Requests","status":429,"message":"Rate limit exceeded"}`)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"error":"Unauthorized","status":401,"message":"Invalid OAuth token"}`)
+	}))
+	defer ts.Close()
+
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client, err := NewClient(host, portNum, "foo", false)
+	require.NoError(t, err)
+
+	_, err = client.GetRequest("users", map[string][]string{"id": {"1"}})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	apiErr := APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "Invalid OAuth token", apiErr.Message)
+	require.Equal(t, ts.URL+"/api/twitch/helix/users/?id=1", apiErr.URL)
+	require.Equal(t, fmt.Sprintf("twitch responded 401 Unauthorized: Invalid OAuth token (%s/api/twitch/helix/users/?id=1)", ts.URL), err.Error())
+
+	_, err = client.GetRequest("users", map[string][]string{"id": {"slow"}})
+	require.IsType(t, RateLimitError{}, err)
+	require.ErrorIs(t, err, ErrTooManyRequests)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "Rate limit exceeded", apiErr.Message)
+}
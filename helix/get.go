@@ -1,6 +1,7 @@
 package helix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	gotau "github.com/Team-TAU/tau-client-go"
@@ -10,12 +11,22 @@ import (
 
 // GetRequest runs a raw GET request against the twitch helix pass thru, and returns the []byte of the data.
 func (c *Client) GetRequest(endpoint string, queryParams map[string][]string) ([]byte, error) {
-	return c.helixRequest(endpoint, queryParams, nil, "GET")
+	return c.GetRequestWithContext(context.Background(), endpoint, queryParams)
+}
+
+// GetRequestWithContext is the same as GetRequest but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetRequestWithContext(ctx context.Context, endpoint string, queryParams map[string][]string) ([]byte, error) {
+	return c.helixRequest(ctx, endpoint, queryParams, nil, "GET")
 }
 
 // GetTwitchUsers makes an api call to https://dev.twitch.tv/docs/api/reference#get-users, and formats the data.
 // This does some limited validation based on the API definition.
 func (c *Client) GetTwitchUsers(logins []string, ids []string) (*Users, error) {
+	return c.GetTwitchUsersWithContext(context.Background(), logins, ids)
+}
+
+// GetTwitchUsersWithContext is the same as GetTwitchUsers but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetTwitchUsersWithContext(ctx context.Context, logins []string, ids []string) (*Users, error) {
 	if len(logins)+len(ids) > 100 {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, get users only supports a maximum of 100 total logins and ids combined.",
@@ -25,7 +36,7 @@ func (c *Client) GetTwitchUsers(logins []string, ids []string) (*Users, error) {
 	params := make(map[string][]string)
 	params["login"] = logins
 	params["id"] = ids
-	body, err := c.GetRequest("users", params)
+	body, err := c.GetRequestWithContext(ctx, "users", params)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +50,11 @@ func (c *Client) GetTwitchUsers(logins []string, ids []string) (*Users, error) {
 
 // GetBitsLeaderboard makes an api call to https://dev.twitch.tv/docs/api/reference#get-bits-leaderboard and formats the data.
 func (c *Client) GetBitsLeaderboard(count int, period string, startedAt *time.Time, userID string) (*BitsLeaderboard, error) {
+	return c.GetBitsLeaderboardWithContext(context.Background(), count, period, startedAt, userID)
+}
+
+// GetBitsLeaderboardWithContext is the same as GetBitsLeaderboard but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetBitsLeaderboardWithContext(ctx context.Context, count int, period string, startedAt *time.Time, userID string) (*BitsLeaderboard, error) {
 	params := make(map[string][]string)
 	if count > 100 {
 		return nil, gotau.BadRequestError{
@@ -80,7 +96,7 @@ func (c *Client) GetBitsLeaderboard(count int, period string, startedAt *time.Ti
 		params["user_id"] = []string{userID}
 	}
 
-	body, err := c.GetRequest("bits/leaderboard", params)
+	body, err := c.GetRequestWithContext(ctx, "bits/leaderboard", params)
 	if err != nil {
 		return nil, err
 	}
@@ -96,13 +112,18 @@ func (c *Client) GetBitsLeaderboard(count int, period string, startedAt *time.Ti
 
 // GetCheermotes makes an api call to https://dev.twitch.tv/docs/api/reference#get-cheermotes and formats the data.
 func (c *Client) GetCheermotes(broadcasterID string) (*CheermotesList, error) {
+	return c.GetCheermotesWithContext(context.Background(), broadcasterID)
+}
+
+// GetCheermotesWithContext is the same as GetCheermotes but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetCheermotesWithContext(ctx context.Context, broadcasterID string) (*CheermotesList, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	params := make(map[string][]string)
 	if broadcasterID != "" {
 		params["broadcaster_id"] = []string{broadcasterID}
 	}
 
-	body, err := c.GetRequest("bits/cheermotes", params)
+	body, err := c.GetRequestWithContext(ctx, "bits/cheermotes", params)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +138,11 @@ func (c *Client) GetCheermotes(broadcasterID string) (*CheermotesList, error) {
 
 // GetChannelInformation makes an api call to https://dev.twitch.tv/docs/api/reference#get-channel-information and formats the data.
 func (c *Client) GetChannelInformation(broadcasterID string) (*ChannelInformation, error) {
+	return c.GetChannelInformationWithContext(context.Background(), broadcasterID)
+}
+
+// GetChannelInformationWithContext is the same as GetChannelInformation but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetChannelInformationWithContext(ctx context.Context, broadcasterID string) (*ChannelInformation, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -125,7 +151,7 @@ func (c *Client) GetChannelInformation(broadcasterID string) (*ChannelInformatio
 	}
 	param := make(map[string][]string)
 	param["broadcaster_id"] = []string{broadcasterID}
-	body, err := c.GetRequest("channels", param)
+	body, err := c.GetRequestWithContext(ctx, "channels", param)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +167,11 @@ func (c *Client) GetChannelInformation(broadcasterID string) (*ChannelInformatio
 
 // GetChannelEditors makes an api call to https://dev.twitch.tv/docs/api/reference#get-channel-editors and formats the data.
 func (c *Client) GetChannelEditors(broadcasterID string) (*ChannelEditors, error) {
+	return c.GetChannelEditorsWithContext(context.Background(), broadcasterID)
+}
+
+// GetChannelEditorsWithContext is the same as GetChannelEditors but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetChannelEditorsWithContext(ctx context.Context, broadcasterID string) (*ChannelEditors, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -150,7 +181,7 @@ func (c *Client) GetChannelEditors(broadcasterID string) (*ChannelEditors, error
 
 	param := make(map[string][]string)
 	param["broadcaster_id"] = []string{broadcasterID}
-	body, err := c.GetRequest("channels/editors", param)
+	body, err := c.GetRequestWithContext(ctx, "channels/editors", param)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +197,11 @@ func (c *Client) GetChannelEditors(broadcasterID string) (*ChannelEditors, error
 
 // GetCustomRewards makes an api call to https://dev.twitch.tv/docs/api/reference#get-custom-reward and formats the data.
 func (c *Client) GetCustomRewards(broadcasterID string, rewardID []string, onlyManageableRewards bool) (*CustomRewards, error) {
+	return c.GetCustomRewardsWithContext(context.Background(), broadcasterID, rewardID, onlyManageableRewards)
+}
+
+// GetCustomRewardsWithContext is the same as GetCustomRewards but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetCustomRewardsWithContext(ctx context.Context, broadcasterID string, rewardID []string, onlyManageableRewards bool) (*CustomRewards, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -188,7 +224,7 @@ func (c *Client) GetCustomRewards(broadcasterID string, rewardID []string, onlyM
 		params["only_manageable_rewards"] = []string{"true"}
 	}
 
-	body, err := c.GetRequest("channel_points/custom_rewards", params)
+	body, err := c.GetRequestWithContext(ctx, "channel_points/custom_rewards", params)
 	if err != nil {
 		return nil, err
 	}
@@ -203,8 +239,15 @@ func (c *Client) GetCustomRewards(broadcasterID string, rewardID []string, onlyM
 }
 
 // GetCustomRewardRedemption makes an api call to https://dev.twitch.tv/docs/api/reference#get-custom-reward-redemption and formats the data.
+// status is converted to twitch's upper case so the EventSub spelling works too.
 func (c *Client) GetCustomRewardRedemption(broadcasterID string, rewardID string, redemptionID []string,
-	status string, sort string, cursor string, resultCount int) (*CustomRewardRedemptions, error) {
+	status gotau.RedemptionStatus, sort string, cursor string, resultCount int) (*CustomRewardRedemptions, error) {
+	return c.GetCustomRewardRedemptionWithContext(context.Background(), broadcasterID, rewardID, redemptionID, status, sort, cursor, resultCount)
+}
+
+// GetCustomRewardRedemptionWithContext is the same as GetCustomRewardRedemption but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetCustomRewardRedemptionWithContext(ctx context.Context, broadcasterID string, rewardID string, redemptionID []string,
+	status gotau.RedemptionStatus, sort string, cursor string, resultCount int) (*CustomRewardRedemptions, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -229,7 +272,7 @@ func (c *Client) GetCustomRewardRedemption(broadcasterID string, rewardID string
 		params["id"] = redemptionID
 	}
 
-	status = strings.TrimSpace(status)
+	status = gotau.RedemptionStatus(strings.TrimSpace(string(status)))
 	if len(redemptionID) == 0 && status == "" {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, if there are no redemptionIDs, status must be set",
@@ -237,13 +280,13 @@ func (c *Client) GetCustomRewardRedemption(broadcasterID string, rewardID string
 	}
 
 	if status != "" {
-		switch status {
-		case "UNFULFILLED":
+		switch upper := gotau.RedemptionStatus(strings.ToUpper(string(status))); upper {
+		case RedemptionUnfulfilled:
 			fallthrough
-		case "FULFILLED":
+		case RedemptionFulfilled:
 			fallthrough
-		case "CANCELED":
-			params["status"] = []string{status}
+		case RedemptionCanceled:
+			params["status"] = []string{string(upper)}
 		default:
 			return nil, gotau.BadRequestError{
 				Err: fmt.Sprintf("invalid request, status can only be UNFULFILLED, FILFILLED, or CANCELED, but you input %s", status),
@@ -283,7 +326,7 @@ func (c *Client) GetCustomRewardRedemption(broadcasterID string, rewardID string
 		params["after"] = []string{cursor}
 	}
 
-	body, err := c.GetRequest("channel_points/custom_rewards/redemptions", params)
+	body, err := c.GetRequestWithContext(ctx, "channel_points/custom_rewards/redemptions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -298,6 +341,11 @@ func (c *Client) GetCustomRewardRedemption(broadcasterID string, rewardID string
 
 // GetChannelChatBadges makes an api call to https://dev.twitch.tv/docs/api/reference#get-channel-chat-badges and formats the data.
 func (c *Client) GetChannelChatBadges(broadcasterID string) (*ChannelChatBadges, error) {
+	return c.GetChannelChatBadgesWithContext(context.Background(), broadcasterID)
+}
+
+// GetChannelChatBadgesWithContext is the same as GetChannelChatBadges but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetChannelChatBadgesWithContext(ctx context.Context, broadcasterID string) (*ChannelChatBadges, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -307,7 +355,7 @@ func (c *Client) GetChannelChatBadges(broadcasterID string) (*ChannelChatBadges,
 	param := make(map[string][]string)
 	param["broadcaster_id"] = []string{broadcasterID}
 
-	body, err := c.GetRequest("chat/badges", param)
+	body, err := c.GetRequestWithContext(ctx, "chat/badges", param)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +370,12 @@ func (c *Client) GetChannelChatBadges(broadcasterID string) (*ChannelChatBadges,
 
 // GetGlobalChatBadges makes an api call to https://dev.twitch.tv/docs/api/reference#get-global-chat-badges and formats the data.
 func (c *Client) GetGlobalChatBadges() (*ChannelChatBadges, error) {
-	body, err := c.GetRequest("chat/badges/global", nil)
+	return c.GetGlobalChatBadgesWithContext(context.Background())
+}
+
+// GetGlobalChatBadgesWithContext is the same as GetGlobalChatBadges but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetGlobalChatBadgesWithContext(ctx context.Context) (*ChannelChatBadges, error) {
+	body, err := c.GetRequestWithContext(ctx, "chat/badges/global", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -337,6 +390,12 @@ func (c *Client) GetGlobalChatBadges() (*ChannelChatBadges, error) {
 
 // GetClipsByBroadcaster makes an api call to https://dev.twitch.tv/docs/api/reference#get-clips based on broadcaster, and formats the data.
 func (c *Client) GetClipsByBroadcaster(broadcasterID, after, before string, startedAt,
+	endedAt *time.Time, count int) (*Clips, error) {
+	return c.GetClipsByBroadcasterWithContext(context.Background(), broadcasterID, after, before, startedAt, endedAt, count)
+}
+
+// GetClipsByBroadcasterWithContext is the same as GetClipsByBroadcaster but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetClipsByBroadcasterWithContext(ctx context.Context, broadcasterID, after, before string, startedAt,
 	endedAt *time.Time, count int) (*Clips, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
@@ -371,7 +430,7 @@ func (c *Client) GetClipsByBroadcaster(broadcasterID, after, before string, star
 		params["first"] = []string{fmt.Sprintf("%d", count)}
 	}
 
-	body, err := c.GetRequest("clips", params)
+	body, err := c.GetRequestWithContext(ctx, "clips", params)
 	if err != nil {
 		return nil, err
 	}
@@ -387,6 +446,12 @@ func (c *Client) GetClipsByBroadcaster(broadcasterID, after, before string, star
 
 // GetClipsByGame makes an api call to https://dev.twitch.tv/docs/api/reference#get-clips based on game, and formats the data.
 func (c *Client) GetClipsByGame(gameID, after, before string, startedAt,
+	endedAt *time.Time, count int) (*Clips, error) {
+	return c.GetClipsByGameWithContext(context.Background(), gameID, after, before, startedAt, endedAt, count)
+}
+
+// GetClipsByGameWithContext is the same as GetClipsByGame but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetClipsByGameWithContext(ctx context.Context, gameID, after, before string, startedAt,
 	endedAt *time.Time, count int) (*Clips, error) {
 	gameID = strings.TrimSpace(gameID)
 	if gameID == "" {
@@ -421,7 +486,7 @@ func (c *Client) GetClipsByGame(gameID, after, before string, startedAt,
 		params["first"] = []string{fmt.Sprintf("%d", count)}
 	}
 
-	body, err := c.GetRequest("clips", params)
+	body, err := c.GetRequestWithContext(ctx, "clips", params)
 	if err != nil {
 		return nil, err
 	}
@@ -437,6 +502,12 @@ func (c *Client) GetClipsByGame(gameID, after, before string, startedAt,
 
 // GetClipsByID makes an api call to https://dev.twitch.tv/docs/api/reference#get-clips based on clip id, and formats the data.
 func (c *Client) GetClipsByID(clipID []string, after, before string, startedAt,
+	endedAt *time.Time, count int) (*Clips, error) {
+	return c.GetClipsByIDWithContext(context.Background(), clipID, after, before, startedAt, endedAt, count)
+}
+
+// GetClipsByIDWithContext is the same as GetClipsByID but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetClipsByIDWithContext(ctx context.Context, clipID []string, after, before string, startedAt,
 	endedAt *time.Time, count int) (*Clips, error) {
 	if len(clipID) == 0 {
 		return nil, gotau.BadRequestError{
@@ -475,7 +546,7 @@ func (c *Client) GetClipsByID(clipID []string, after, before string, startedAt,
 		params["first"] = []string{fmt.Sprintf("%d", count)}
 	}
 
-	body, err := c.GetRequest("clips", params)
+	body, err := c.GetRequestWithContext(ctx, "clips", params)
 	if err != nil {
 		return nil, err
 	}
@@ -491,6 +562,11 @@ func (c *Client) GetClipsByID(clipID []string, after, before string, startedAt,
 
 // GetEventSubSubscriptions makes an api call to https://dev.twitch.tv/docs/api/reference#get-eventsub-subscriptions, and formats the data.
 func (c *Client) GetEventSubSubscriptions(status, eventType string) (*EventSubSubscriptions, error) {
+	return c.GetEventSubSubscriptionsWithContext(context.Background(), status, eventType)
+}
+
+// GetEventSubSubscriptionsWithContext is the same as GetEventSubSubscriptions but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetEventSubSubscriptionsWithContext(ctx context.Context, status, eventType string) (*EventSubSubscriptions, error) {
 	params := make(map[string][]string)
 	if status != "" {
 		params["status"] = []string{status}
@@ -499,7 +575,7 @@ func (c *Client) GetEventSubSubscriptions(status, eventType string) (*EventSubSu
 		params["type"] = []string{eventType}
 	}
 
-	body, err := c.GetRequest("eventsub/subscriptions", params)
+	body, err := c.GetRequestWithContext(ctx, "eventsub/subscriptions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -514,6 +590,11 @@ func (c *Client) GetEventSubSubscriptions(status, eventType string) (*EventSubSu
 
 // GetTopGames makes an api call to https://dev.twitch.tv/docs/api/reference#get-top-games, and formats the data.
 func (c *Client) GetTopGames(before, after string, count int) (*Games, error) {
+	return c.GetTopGamesWithContext(context.Background(), before, after, count)
+}
+
+// GetTopGamesWithContext is the same as GetTopGames but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetTopGamesWithContext(ctx context.Context, before, after string, count int) (*Games, error) {
 	if count > 100 {
 		return nil, gotau.BadRequestError{
 			Err: fmt.Sprintf("invalid request, count maximum value is 100, but you supplied %d", count),
@@ -534,7 +615,7 @@ func (c *Client) GetTopGames(before, after string, count int) (*Games, error) {
 		params["first"] = []string{fmt.Sprintf("%d", count)}
 	}
 
-	body, err := c.GetRequest("games/top", params)
+	body, err := c.GetRequestWithContext(ctx, "games/top", params)
 	if err != nil {
 		return nil, err
 	}
@@ -549,6 +630,11 @@ func (c *Client) GetTopGames(before, after string, count int) (*Games, error) {
 
 // GetGames makes an api call to https://dev.twitch.tv/docs/api/reference#get-games, and formats the data.
 func (c *Client) GetGames(ids, names []string) (*Games, error) {
+	return c.GetGamesWithContext(context.Background(), ids, names)
+}
+
+// GetGamesWithContext is the same as GetGames but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetGamesWithContext(ctx context.Context, ids, names []string) (*Games, error) {
 	if len(ids) == 0 && len(names) == 0 {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, either id or names is necessary",
@@ -564,7 +650,7 @@ func (c *Client) GetGames(ids, names []string) (*Games, error) {
 	params["id"] = ids
 	params["name"] = names
 
-	body, err := c.GetRequest("games", params)
+	body, err := c.GetRequestWithContext(ctx, "games", params)
 	if err != nil {
 		return nil, err
 	}
@@ -580,6 +666,11 @@ func (c *Client) GetGames(ids, names []string) (*Games, error) {
 
 // GetHypeTrainEvents makes an api call to https://dev.twitch.tv/docs/api/reference#get-hype-train-events, and formats the data.
 func (c *Client) GetHypeTrainEvents(broadcasterID string, count int, id, cursor string) (*HypeTrainEvents, error) {
+	return c.GetHypeTrainEventsWithContext(context.Background(), broadcasterID, count, id, cursor)
+}
+
+// GetHypeTrainEventsWithContext is the same as GetHypeTrainEvents but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetHypeTrainEventsWithContext(ctx context.Context, broadcasterID string, count int, id, cursor string) (*HypeTrainEvents, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -607,7 +698,7 @@ func (c *Client) GetHypeTrainEvents(broadcasterID string, count int, id, cursor
 		params["cursor"] = []string{cursor}
 	}
 
-	body, err := c.GetRequest("hypetrain/events", params)
+	body, err := c.GetRequestWithContext(ctx, "hypetrain/events", params)
 	if err != nil {
 		return nil, err
 	}
@@ -622,6 +713,11 @@ func (c *Client) GetHypeTrainEvents(broadcasterID string, count int, id, cursor
 
 // GetBannedEvents makes an api call to https://dev.twitch.tv/docs/api/reference#get-banned-events, and formats the data.
 func (c *Client) GetBannedEvents(broadcasterID, userID, after string, count int) (*BannedEvents, error) {
+	return c.GetBannedEventsWithContext(context.Background(), broadcasterID, userID, after, count)
+}
+
+// GetBannedEventsWithContext is the same as GetBannedEvents but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetBannedEventsWithContext(ctx context.Context, broadcasterID, userID, after string, count int) (*BannedEvents, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -649,7 +745,7 @@ func (c *Client) GetBannedEvents(broadcasterID, userID, after string, count int)
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("moderation/banned/events", params)
+	body, err := c.GetRequestWithContext(ctx, "moderation/banned/events", params)
 	if err != nil {
 		return nil, err
 	}
@@ -665,6 +761,11 @@ func (c *Client) GetBannedEvents(broadcasterID, userID, after string, count int)
 
 // GetModerators makes an api call to https://dev.twitch.tv/docs/api/reference#get-moderators, and formats the data.
 func (c *Client) GetModerators(broadcasterID string, userIDs []string, after string, count int) (*Moderators, error) {
+	return c.GetModeratorsWithContext(context.Background(), broadcasterID, userIDs, after, count)
+}
+
+// GetModeratorsWithContext is the same as GetModerators but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetModeratorsWithContext(ctx context.Context, broadcasterID string, userIDs []string, after string, count int) (*Moderators, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -696,7 +797,7 @@ func (c *Client) GetModerators(broadcasterID string, userIDs []string, after str
 	}
 	params["user_id"] = userIDs
 
-	body, err := c.GetRequest("moderation/moderators", params)
+	body, err := c.GetRequestWithContext(ctx, "moderation/moderators", params)
 	if err != nil {
 		return nil, err
 	}
@@ -712,6 +813,11 @@ func (c *Client) GetModerators(broadcasterID string, userIDs []string, after str
 
 // GetModeratorEvents makes an api call to https://dev.twitch.tv/docs/api/reference#get-moderator-events, and formats the data.
 func (c *Client) GetModeratorEvents(broadcasterID string, userIDs []string, after string, count int) (*ModeratorEvents, error) {
+	return c.GetModeratorEventsWithContext(context.Background(), broadcasterID, userIDs, after, count)
+}
+
+// GetModeratorEventsWithContext is the same as GetModeratorEvents but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetModeratorEventsWithContext(ctx context.Context, broadcasterID string, userIDs []string, after string, count int) (*ModeratorEvents, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -743,7 +849,7 @@ func (c *Client) GetModeratorEvents(broadcasterID string, userIDs []string, afte
 	}
 	params["user_id"] = userIDs
 
-	body, err := c.GetRequest("moderation/moderators/events", params)
+	body, err := c.GetRequestWithContext(ctx, "moderation/moderators/events", params)
 	if err != nil {
 		return nil, err
 	}
@@ -758,6 +864,11 @@ func (c *Client) GetModeratorEvents(broadcasterID string, userIDs []string, afte
 
 // GetPolls makes an api call to https://dev.twitch.tv/docs/api/reference#get-polls, and formats the data.
 func (c *Client) GetPolls(broadcasterID string, IDs []string, after string, count int) (*Polls, error) {
+	return c.GetPollsWithContext(context.Background(), broadcasterID, IDs, after, count)
+}
+
+// GetPollsWithContext is the same as GetPolls but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetPollsWithContext(ctx context.Context, broadcasterID string, IDs []string, after string, count int) (*Polls, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -789,7 +900,7 @@ func (c *Client) GetPolls(broadcasterID string, IDs []string, after string, coun
 	}
 	params["id"] = IDs
 
-	body, err := c.GetRequest("polls", params)
+	body, err := c.GetRequestWithContext(ctx, "polls", params)
 	if err != nil {
 		return nil, err
 	}
@@ -804,6 +915,11 @@ func (c *Client) GetPolls(broadcasterID string, IDs []string, after string, coun
 
 // GetPredictions makes an api call to https://dev.twitch.tv/docs/api/reference#get-predictions, and formats the data.
 func (c *Client) GetPredictions(broadcasterID string, IDs []string, after string, count int) (*Predictions, error) {
+	return c.GetPredictionsWithContext(context.Background(), broadcasterID, IDs, after, count)
+}
+
+// GetPredictionsWithContext is the same as GetPredictions but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetPredictionsWithContext(ctx context.Context, broadcasterID string, IDs []string, after string, count int) (*Predictions, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -835,7 +951,7 @@ func (c *Client) GetPredictions(broadcasterID string, IDs []string, after string
 	}
 	params["id"] = IDs
 
-	body, err := c.GetRequest("predictions", params)
+	body, err := c.GetRequestWithContext(ctx, "predictions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -850,6 +966,11 @@ func (c *Client) GetPredictions(broadcasterID string, IDs []string, after string
 
 // SearchCategories makes an api call to https://dev.twitch.tv/docs/api/reference#search-categories, and formats the data.
 func (c *Client) SearchCategories(query, after string, count int) (*Games, error) {
+	return c.SearchCategoriesWithContext(context.Background(), query, after, count)
+}
+
+// SearchCategoriesWithContext is the same as SearchCategories but takes a context to allow for cancellation and deadlines.
+func (c *Client) SearchCategoriesWithContext(ctx context.Context, query, after string, count int) (*Games, error) {
 	query = strings.TrimSpace(query)
 	if query == "" {
 		return nil, gotau.BadRequestError{
@@ -875,7 +996,7 @@ func (c *Client) SearchCategories(query, after string, count int) (*Games, error
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("search/categories", params)
+	body, err := c.GetRequestWithContext(ctx, "search/categories", params)
 	if err != nil {
 		return nil, err
 	}
@@ -891,6 +1012,11 @@ func (c *Client) SearchCategories(query, after string, count int) (*Games, error
 
 // SearchChannels makes an api call to https://dev.twitch.tv/docs/api/reference#search-channels, and formats the data.
 func (c *Client) SearchChannels(query, after string, count int, liveOnly bool) (*ChannelSearchResults, error) {
+	return c.SearchChannelsWithContext(context.Background(), query, after, count, liveOnly)
+}
+
+// SearchChannelsWithContext is the same as SearchChannels but takes a context to allow for cancellation and deadlines.
+func (c *Client) SearchChannelsWithContext(ctx context.Context, query, after string, count int, liveOnly bool) (*ChannelSearchResults, error) {
 	query = strings.TrimSpace(query)
 	if query == "" {
 		return nil, gotau.BadRequestError{
@@ -919,7 +1045,7 @@ func (c *Client) SearchChannels(query, after string, count int, liveOnly bool) (
 		params["live_only"] = []string{"true"}
 	}
 
-	body, err := c.GetRequest("search/channels", params)
+	body, err := c.GetRequestWithContext(ctx, "search/channels", params)
 	if err != nil {
 		return nil, err
 	}
@@ -934,6 +1060,11 @@ func (c *Client) SearchChannels(query, after string, count int, liveOnly bool) (
 
 // GetStreamKey makes an api call to https://dev.twitch.tv/docs/api/reference#get-stream-key, and formats the data.
 func (c *Client) GetStreamKey(broadcasterID string) (*StreamKey, error) {
+	return c.GetStreamKeyWithContext(context.Background(), broadcasterID)
+}
+
+// GetStreamKeyWithContext is the same as GetStreamKey but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetStreamKeyWithContext(ctx context.Context, broadcasterID string) (*StreamKey, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -943,7 +1074,7 @@ func (c *Client) GetStreamKey(broadcasterID string) (*StreamKey, error) {
 	params := make(map[string][]string)
 	params["broadcaster_id"] = []string{broadcasterID}
 
-	body, err := c.GetRequest("streams/key", params)
+	body, err := c.GetRequestWithContext(ctx, "streams/key", params)
 	if err != nil {
 		return nil, err
 	}
@@ -959,6 +1090,12 @@ func (c *Client) GetStreamKey(broadcasterID string) (*StreamKey, error) {
 
 // GetStreams makes an api call to https://dev.twitch.tv/docs/api/reference#get-streams, and formats the data.
 func (c *Client) GetStreams(before, after string, count int, gameIDs []string, languages []string,
+	userIDs []string, userLogins []string) (*Streams, error) {
+	return c.GetStreamsWithContext(context.Background(), before, after, count, gameIDs, languages, userIDs, userLogins)
+}
+
+// GetStreamsWithContext is the same as GetStreams but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetStreamsWithContext(ctx context.Context, before, after string, count int, gameIDs []string, languages []string,
 	userIDs []string, userLogins []string) (*Streams, error) {
 	if count > 100 {
 		return nil, gotau.BadRequestError{
@@ -1010,7 +1147,7 @@ func (c *Client) GetStreams(before, after string, count int, gameIDs []string, l
 		params["user_login"] = userLogins
 	}
 
-	body, err := c.GetRequest("streams", params)
+	body, err := c.GetRequestWithContext(ctx, "streams", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1025,6 +1162,11 @@ func (c *Client) GetStreams(before, after string, count int, gameIDs []string, l
 
 // GetFollowedStreams makes an api call to https://dev.twitch.tv/docs/api/reference#get-followed-streams, and formats the data.
 func (c *Client) GetFollowedStreams(userID, after string, count int) (*Streams, error) {
+	return c.GetFollowedStreamsWithContext(context.Background(), userID, after, count)
+}
+
+// GetFollowedStreamsWithContext is the same as GetFollowedStreams but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetFollowedStreamsWithContext(ctx context.Context, userID, after string, count int) (*Streams, error) {
 	userID = strings.TrimSpace(userID)
 	if userID == "" {
 		return nil, gotau.BadRequestError{
@@ -1049,7 +1191,7 @@ func (c *Client) GetFollowedStreams(userID, after string, count int) (*Streams,
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("streams/followed", params)
+	body, err := c.GetRequestWithContext(ctx, "streams/followed", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1064,6 +1206,11 @@ func (c *Client) GetFollowedStreams(userID, after string, count int) (*Streams,
 
 // GetStreamMarkers makes an api call to https://dev.twitch.tv/docs/api/reference#get-stream-markers, and formats the data.
 func (c *Client) GetStreamMarkers(userID, videoID, before, after string, count int) (*StreamMarkers, error) {
+	return c.GetStreamMarkersWithContext(context.Background(), userID, videoID, before, after, count)
+}
+
+// GetStreamMarkersWithContext is the same as GetStreamMarkers but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetStreamMarkersWithContext(ctx context.Context, userID, videoID, before, after string, count int) (*StreamMarkers, error) {
 	userID = strings.TrimSpace(userID)
 	videoID = strings.TrimSpace(videoID)
 	if userID == "" && videoID == "" {
@@ -1102,7 +1249,7 @@ func (c *Client) GetStreamMarkers(userID, videoID, before, after string, count i
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("streams/markers", params)
+	body, err := c.GetRequestWithContext(ctx, "streams/markers", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1117,6 +1264,11 @@ func (c *Client) GetStreamMarkers(userID, videoID, before, after string, count i
 
 // GetBroadcasterSubscriptions makes an api call to https://dev.twitch.tv/docs/api/reference#get-stream-markers, and formats the data.
 func (c *Client) GetBroadcasterSubscriptions(broadcasterID string, userIDs []string, after string, count int) (*Subscriptions, error) {
+	return c.GetBroadcasterSubscriptionsWithContext(context.Background(), broadcasterID, userIDs, after, count)
+}
+
+// GetBroadcasterSubscriptionsWithContext is the same as GetBroadcasterSubscriptions but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetBroadcasterSubscriptionsWithContext(ctx context.Context, broadcasterID string, userIDs []string, after string, count int) (*Subscriptions, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -1145,7 +1297,7 @@ func (c *Client) GetBroadcasterSubscriptions(broadcasterID string, userIDs []str
 		params["first"] = []string{fmt.Sprintf("%d", count)}
 	}
 
-	body, err := c.GetRequest("subscriptions", params)
+	body, err := c.GetRequestWithContext(ctx, "subscriptions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1160,6 +1312,11 @@ func (c *Client) GetBroadcasterSubscriptions(broadcasterID string, userIDs []str
 
 // CheckUserSubscription makes an api call to https://dev.twitch.tv/docs/api/reference#check-user-subscription, and formats the data.
 func (c *Client) CheckUserSubscription(broadcasterID, userID string) (*UserSubscriptions, error) {
+	return c.CheckUserSubscriptionWithContext(context.Background(), broadcasterID, userID)
+}
+
+// CheckUserSubscriptionWithContext is the same as CheckUserSubscription but takes a context to allow for cancellation and deadlines.
+func (c *Client) CheckUserSubscriptionWithContext(ctx context.Context, broadcasterID, userID string) (*UserSubscriptions, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	userID = strings.TrimSpace(userID)
 	if broadcasterID == "" {
@@ -1177,7 +1334,7 @@ func (c *Client) CheckUserSubscription(broadcasterID, userID string) (*UserSubsc
 	params["broadcaster_id"] = []string{broadcasterID}
 	params["user_id"] = []string{userID}
 
-	body, err := c.GetRequest("subscriptions/user", params)
+	body, err := c.GetRequestWithContext(ctx, "subscriptions/user", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1193,6 +1350,11 @@ func (c *Client) CheckUserSubscription(broadcasterID, userID string) (*UserSubsc
 
 // GetAllStreamTags makes an api call to https://dev.twitch.tv/docs/api/reference#get-all-stream-tags, and formats the data.
 func (c *Client) GetAllStreamTags(after string, count int, tagIDs []string) (*StreamTags, error) {
+	return c.GetAllStreamTagsWithContext(context.Background(), after, count, tagIDs)
+}
+
+// GetAllStreamTagsWithContext is the same as GetAllStreamTags but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetAllStreamTagsWithContext(ctx context.Context, after string, count int, tagIDs []string) (*StreamTags, error) {
 	if count > 100 {
 		return nil, gotau.BadRequestError{
 			Err: fmt.Sprintf("invalid request, count maximum value is 100, but you supplied %d", count),
@@ -1219,7 +1381,7 @@ func (c *Client) GetAllStreamTags(after string, count int, tagIDs []string) (*St
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("tags/streams", params)
+	body, err := c.GetRequestWithContext(ctx, "tags/streams", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1234,6 +1396,11 @@ func (c *Client) GetAllStreamTags(after string, count int, tagIDs []string) (*St
 
 // GetStreamTags makes an api call to https://dev.twitch.tv/docs/api/reference#get-stream-tags, and formats the data.
 func (c *Client) GetStreamTags(broadcasterID string) (*StreamTags, error) {
+	return c.GetStreamTagsWithContext(context.Background(), broadcasterID)
+}
+
+// GetStreamTagsWithContext is the same as GetStreamTags but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetStreamTagsWithContext(ctx context.Context, broadcasterID string) (*StreamTags, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -1243,7 +1410,7 @@ func (c *Client) GetStreamTags(broadcasterID string) (*StreamTags, error) {
 	params := make(map[string][]string)
 	params["broadcaster_id"] = []string{broadcasterID}
 
-	body, err := c.GetRequest("streams/tags", params)
+	body, err := c.GetRequestWithContext(ctx, "streams/tags", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1259,6 +1426,11 @@ func (c *Client) GetStreamTags(broadcasterID string) (*StreamTags, error) {
 
 // GetChannelTeams makes an api call to https://dev.twitch.tv/docs/api/reference#get-channel-teams, and formats the data.
 func (c *Client) GetChannelTeams(broadcasterID string) (*ChannelTeams, error) {
+	return c.GetChannelTeamsWithContext(context.Background(), broadcasterID)
+}
+
+// GetChannelTeamsWithContext is the same as GetChannelTeams but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetChannelTeamsWithContext(ctx context.Context, broadcasterID string) (*ChannelTeams, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -1269,7 +1441,7 @@ func (c *Client) GetChannelTeams(broadcasterID string) (*ChannelTeams, error) {
 	params := make(map[string][]string)
 	params["broadcaster_id"] = []string{broadcasterID}
 
-	body, err := c.GetRequest("teams/channel", params)
+	body, err := c.GetRequestWithContext(ctx, "teams/channel", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1285,6 +1457,11 @@ func (c *Client) GetChannelTeams(broadcasterID string) (*ChannelTeams, error) {
 
 // GetTeam makes an api call to https://dev.twitch.tv/docs/api/reference#get-teams, and formats the data.
 func (c *Client) GetTeam(name, id string) (*Teams, error) {
+	return c.GetTeamWithContext(context.Background(), name, id)
+}
+
+// GetTeamWithContext is the same as GetTeam but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetTeamWithContext(ctx context.Context, name, id string) (*Teams, error) {
 	name = strings.TrimSpace(name)
 	id = strings.TrimSpace(id)
 
@@ -1305,7 +1482,7 @@ func (c *Client) GetTeam(name, id string) (*Teams, error) {
 		params["id"] = []string{id}
 	}
 
-	body, err := c.GetRequest("teams", params)
+	body, err := c.GetRequestWithContext(ctx, "teams", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1320,6 +1497,11 @@ func (c *Client) GetTeam(name, id string) (*Teams, error) {
 
 // GetUsers makes an api call to https://dev.twitch.tv/docs/api/reference#get-users, and formats the data.
 func (c *Client) GetUsers(IDs, logins []string) (*Users, error) {
+	return c.GetUsersWithContext(context.Background(), IDs, logins)
+}
+
+// GetUsersWithContext is the same as GetUsers but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetUsersWithContext(ctx context.Context, IDs, logins []string) (*Users, error) {
 	if len(IDs) == 0 && len(logins) == 0 {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, login or id must be specified",
@@ -1339,7 +1521,7 @@ func (c *Client) GetUsers(IDs, logins []string) (*Users, error) {
 		params["login"] = logins
 	}
 
-	body, err := c.GetRequest("users", params)
+	body, err := c.GetRequestWithContext(ctx, "users", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,6 +1537,11 @@ func (c *Client) GetUsers(IDs, logins []string) (*Users, error) {
 
 // GetUsersFollows makes an api call to https://dev.twitch.tv/docs/api/reference#get-users-follows, and formats the data.
 func (c *Client) GetUsersFollows(fromID, toID, after string, count int) (*UserFollows, error) {
+	return c.GetUsersFollowsWithContext(context.Background(), fromID, toID, after, count)
+}
+
+// GetUsersFollowsWithContext is the same as GetUsersFollows but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetUsersFollowsWithContext(ctx context.Context, fromID, toID, after string, count int) (*UserFollows, error) {
 	fromID = strings.TrimSpace(fromID)
 	toID = strings.TrimSpace(toID)
 	if fromID == "" && toID == "" {
@@ -1386,7 +1573,7 @@ func (c *Client) GetUsersFollows(fromID, toID, after string, count int) (*UserFo
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("users/follows", params)
+	body, err := c.GetRequestWithContext(ctx, "users/follows", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1402,6 +1589,11 @@ func (c *Client) GetUsersFollows(fromID, toID, after string, count int) (*UserFo
 
 // GetUsersBlockList makes an api call to https://dev.twitch.tv/docs/api/reference#get-user-block-list, and formats the data.
 func (c *Client) GetUsersBlockList(broadcasterID, after string, count int) (*UserBlockList, error) {
+	return c.GetUsersBlockListWithContext(context.Background(), broadcasterID, after, count)
+}
+
+// GetUsersBlockListWithContext is the same as GetUsersBlockList but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetUsersBlockListWithContext(ctx context.Context, broadcasterID, after string, count int) (*UserBlockList, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -1427,7 +1619,7 @@ func (c *Client) GetUsersBlockList(broadcasterID, after string, count int) (*Use
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("users/blocks", params)
+	body, err := c.GetRequestWithContext(ctx, "users/blocks", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1443,7 +1635,12 @@ func (c *Client) GetUsersBlockList(broadcasterID, after string, count int) (*Use
 
 // GetUserExtensions makes an api call to https://dev.twitch.tv/docs/api/reference#get-user-extensions, and formats the data.
 func (c *Client) GetUserExtensions() (*UserExtensions, error) {
-	body, err := c.GetRequest("users/extensions/list", nil)
+	return c.GetUserExtensionsWithContext(context.Background())
+}
+
+// GetUserExtensionsWithContext is the same as GetUserExtensions but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetUserExtensionsWithContext(ctx context.Context) (*UserExtensions, error) {
+	body, err := c.GetRequestWithContext(ctx, "users/extensions/list", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1459,13 +1656,18 @@ func (c *Client) GetUserExtensions() (*UserExtensions, error) {
 
 // GetUserActiveExtensions makes an api call to https://dev.twitch.tv/docs/api/reference#get-user-active-extensions, and formats the data.
 func (c *Client) GetUserActiveExtensions(userID string) (*UserActiveExtensions, error) {
+	return c.GetUserActiveExtensionsWithContext(context.Background(), userID)
+}
+
+// GetUserActiveExtensionsWithContext is the same as GetUserActiveExtensions but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetUserActiveExtensionsWithContext(ctx context.Context, userID string) (*UserActiveExtensions, error) {
 	userID = strings.TrimSpace(userID)
 	params := make(map[string][]string)
 	if userID != "" {
 		params["user_id"] = []string{userID}
 	}
 
-	body, err := c.GetRequest("users/extensions", params)
+	body, err := c.GetRequestWithContext(ctx, "users/extensions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1481,6 +1683,11 @@ func (c *Client) GetUserActiveExtensions(userID string) (*UserActiveExtensions,
 
 // GetVideosByID makes an api call to https://dev.twitch.tv/docs/api/reference#get-videos, and formats the data.
 func (c *Client) GetVideosByID(ids []string) (*Video, error) {
+	return c.GetVideosByIDWithContext(context.Background(), ids)
+}
+
+// GetVideosByIDWithContext is the same as GetVideosByID but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetVideosByIDWithContext(ctx context.Context, ids []string) (*Video, error) {
 	if len(ids) == 0 {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, at least one video id is required",
@@ -1495,7 +1702,7 @@ func (c *Client) GetVideosByID(ids []string) (*Video, error) {
 	params := make(map[string][]string)
 	params["id"] = ids
 
-	body, err := c.GetRequest("videos", params)
+	body, err := c.GetRequestWithContext(ctx, "videos", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1511,6 +1718,12 @@ func (c *Client) GetVideosByID(ids []string) (*Video, error) {
 
 // GetVideosByUser makes an api call to https://dev.twitch.tv/docs/api/reference#get-videos, and formats the data.
 func (c *Client) GetVideosByUser(userID, before, after, language string, count int,
+	period, sort, _type string) (*Video, error) {
+	return c.GetVideosByUserWithContext(context.Background(), userID, before, after, language, count, period, sort, _type)
+}
+
+// GetVideosByUserWithContext is the same as GetVideosByUser but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetVideosByUserWithContext(ctx context.Context, userID, before, after, language string, count int,
 	period, sort, _type string) (*Video, error) {
 	userID = strings.TrimSpace(userID)
 	if userID == "" {
@@ -1592,7 +1805,7 @@ func (c *Client) GetVideosByUser(userID, before, after, language string, count i
 		}
 	}
 
-	body, err := c.GetRequest("videos", params)
+	body, err := c.GetRequestWithContext(ctx, "videos", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1608,6 +1821,12 @@ func (c *Client) GetVideosByUser(userID, before, after, language string, count i
 
 // GetVideosByGame makes an api call to https://dev.twitch.tv/docs/api/reference#get-videos, and formats the data.
 func (c *Client) GetVideosByGame(gameID, before, after, language string, count int,
+	period, sort, _type string) (*Video, error) {
+	return c.GetVideosByGameWithContext(context.Background(), gameID, before, after, language, count, period, sort, _type)
+}
+
+// GetVideosByGameWithContext is the same as GetVideosByGame but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetVideosByGameWithContext(ctx context.Context, gameID, before, after, language string, count int,
 	period, sort, _type string) (*Video, error) {
 	gameID = strings.TrimSpace(gameID)
 	if gameID == "" {
@@ -1689,7 +1908,7 @@ func (c *Client) GetVideosByGame(gameID, before, after, language string, count i
 		}
 	}
 
-	body, err := c.GetRequest("videos", params)
+	body, err := c.GetRequestWithContext(ctx, "videos", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1705,6 +1924,11 @@ func (c *Client) GetVideosByGame(gameID, before, after, language string, count i
 
 // GetWebhookSubscriptions makes an api call to https://dev.twitch.tv/docs/api/reference#get-webhook-subscriptions, and formats the data.
 func (c *Client) GetWebhookSubscriptions(after string, count int) (*WebhookSubscriptions, error) {
+	return c.GetWebhookSubscriptionsWithContext(context.Background(), after, count)
+}
+
+// GetWebhookSubscriptionsWithContext is the same as GetWebhookSubscriptions but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetWebhookSubscriptionsWithContext(ctx context.Context, after string, count int) (*WebhookSubscriptions, error) {
 	if count > 100 {
 		return nil, gotau.BadRequestError{
 			Err: fmt.Sprintf("invalid request, count maximum value is 100, but you supplied %d", count),
@@ -1723,7 +1947,7 @@ func (c *Client) GetWebhookSubscriptions(after string, count int) (*WebhookSubsc
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("webhooks/subscriptions", params)
+	body, err := c.GetRequestWithContext(ctx, "webhooks/subscriptions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1739,6 +1963,12 @@ func (c *Client) GetWebhookSubscriptions(after string, count int) (*WebhookSubsc
 
 // GetChannelStreamSchedule makes an api call to https://dev.twitch.tv/docs/api/reference#get-channel-stream-schedule, and formats the data.
 func (c *Client) GetChannelStreamSchedule(broadcasterID string, IDs []string, startTime *time.Time,
+	utcOffset, count int, after string) (*ChannelStreamSchedule, error) {
+	return c.GetChannelStreamScheduleWithContext(context.Background(), broadcasterID, IDs, startTime, utcOffset, count, after)
+}
+
+// GetChannelStreamScheduleWithContext is the same as GetChannelStreamSchedule but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetChannelStreamScheduleWithContext(ctx context.Context, broadcasterID string, IDs []string, startTime *time.Time,
 	utcOffset, count int, after string) (*ChannelStreamSchedule, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
@@ -1779,7 +2009,7 @@ func (c *Client) GetChannelStreamSchedule(broadcasterID string, IDs []string, st
 		params["after"] = []string{after}
 	}
 
-	body, err := c.GetRequest("schedule", params)
+	body, err := c.GetRequestWithContext(ctx, "schedule", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1796,6 +2026,11 @@ func (c *Client) GetChannelStreamSchedule(broadcasterID string, IDs []string, st
 // GetChannelStreamScheduleAsICal gets the iCal format schedule for a stream.  This is returned as a []byte to allow
 //for use with your iCal library of choice.  See https://dev.twitch.tv/docs/api/reference#get-channel-icalendar.
 func (c *Client) GetChannelStreamScheduleAsICal(broadcasterID string) ([]byte, error) {
+	return c.GetChannelStreamScheduleAsICalWithContext(context.Background(), broadcasterID)
+}
+
+// GetChannelStreamScheduleAsICalWithContext is the same as GetChannelStreamScheduleAsICal but takes a context to allow for cancellation and deadlines.
+func (c *Client) GetChannelStreamScheduleAsICalWithContext(ctx context.Context, broadcasterID string) ([]byte, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -1807,5 +2042,5 @@ func (c *Client) GetChannelStreamScheduleAsICal(broadcasterID string) ([]byte, e
 		"broadcaster_id": {broadcasterID},
 	}
 
-	return c.GetRequest("schedule/icalendar", params)
+	return c.GetRequestWithContext(ctx, "schedule/icalendar", params)
 }
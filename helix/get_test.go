@@ -1,6 +1,8 @@
 package helix
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	gotau "github.com/Team-TAU/tau-client-go"
 	"github.com/stretchr/testify/require"
@@ -506,7 +508,9 @@ func TestClient_GetChannelEditorsReturns401(t *testing.T) {
 
 	info, err := client.GetChannelInformation("141981764")
 	require.Error(t, err)
-	require.IsType(t, gotau.AuthorizationError{}, err)
+	require.IsType(t, APIError{}, err)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
 	require.Nil(t, info)
 }
 
@@ -629,7 +633,9 @@ func TestClient_GetCustomRewardsReturns401(t *testing.T) {
 
 	rewards, err := client.GetCustomRewards("141981764", nil, false)
 	require.Error(t, err)
-	require.IsType(t, gotau.AuthorizationError{}, err)
+	require.IsType(t, APIError{}, err)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
 	require.Nil(t, rewards)
 }
 
@@ -791,7 +797,7 @@ func TestClient_GetCustomRewardRedemptionReturns200(t *testing.T) {
 	require.Equal(t, "torpedo09", data.UserLogin)
 	require.Equal(t, "274637212", data.UserID)
 	require.Zero(t, data.UserInput)
-	require.Equal(t, "CANCELED", data.Status)
+	require.Equal(t, RedemptionCanceled, data.Status)
 	require.Equal(t, 2020, data.RedeemedAt.Year())
 	require.NotNil(t, data.Reward)
 	require.Equal(t, "92af127c-7326-4483-a52b-b0da0be61c01", data.Reward.ID)
@@ -847,7 +853,9 @@ func TestClient_GetCustomRewardRedemptionReturns401(t *testing.T) {
 
 	rewards, err := client.GetCustomRewardRedemption("abcdefg", "12345", nil, "CANCELED", "", "", 0)
 	require.Error(t, err)
-	require.IsType(t, gotau.AuthorizationError{}, err)
+	require.IsType(t, APIError{}, err)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
 	require.Nil(t, rewards)
 }
 
@@ -1017,7 +1025,9 @@ func TestClient_GetChannelChatBadgesReturns401(t *testing.T) {
 
 	badges, err := client.GetChannelChatBadges("12345")
 	require.Error(t, err)
-	require.IsType(t, gotau.AuthorizationError{}, err)
+	require.IsType(t, APIError{}, err)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
 	require.Nil(t, badges)
 }
 
@@ -1097,7 +1107,9 @@ func TestClient_GetGlobalChatBadgesReturns401(t *testing.T) {
 
 	badges, err := client.GetGlobalChatBadges()
 	require.Error(t, err)
-	require.IsType(t, gotau.AuthorizationError{}, err)
+	require.IsType(t, APIError{}, err)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.ErrorIs(t, err, gotau.AuthorizationError{})
 	require.Nil(t, badges)
 }
 
@@ -1472,11 +1484,11 @@ func TestClient_GetHypeTrainEventsReturns200(t *testing.T) {
 	require.Equal(t, 2020, data.EventData.StartedAt.Year())
 	require.Equal(t, 600, data.EventData.Total)
 	require.Equal(t, 200, data.EventData.LastContribution.Total)
-	require.Equal(t, "BITS", data.EventData.LastContribution.Type)
+	require.Equal(t, ContributionBits, data.EventData.LastContribution.Type)
 	require.Equal(t, "134247454", data.EventData.LastContribution.User)
 	require.Len(t, data.EventData.TopContributions, 1)
 	require.Equal(t, 600, data.EventData.TopContributions[0].Total)
-	require.Equal(t, "BITS", data.EventData.TopContributions[0].Type)
+	require.Equal(t, ContributionBits, data.EventData.TopContributions[0].Type)
 	require.Equal(t, "134247450", data.EventData.TopContributions[0].User)
 }
 
@@ -1729,7 +1741,7 @@ func TestClient_GetPollsReturns200(t *testing.T) {
 	require.Equal(t, 0, polls.Data[0].BitsPerVote)
 	require.False(t, polls.Data[0].ChannelPointsVotingEnabled)
 	require.Equal(t, 0, polls.Data[0].ChannelPointsPerVote)
-	require.Equal(t, "ACTIVE", polls.Data[0].Status)
+	require.Equal(t, PollActive, polls.Data[0].Status)
 	require.Equal(t, 1800, polls.Data[0].Duration)
 	require.Equal(t, 2021, polls.Data[0].StartedAt.Year())
 
@@ -1776,7 +1788,7 @@ func TestClient_GetPredictionsReturns200(t *testing.T) {
 		require.Equal(t, "12345", r.URL.Query().Get("broadcaster_id"))
 		require.Equal(t, "broadcaster_id=12345", r.URL.Query().Encode())
 		w.WriteHeader(http.StatusOK)
-		_, err := fmt.Fprint(w, "{\"data\":[{\"id\":\"d6676d5c-c86e-44d2-bfc4-100fb48f0656\",\"broadcaster_id\":\"55696719\",\"broadcaster_name\":\"TwitchDev\",\"broadcaster_login\":\"twitchdev\",\"title\":\"Will there be any leaks today?\",\"winning_outcome_id\":null,\"outcomes\":[{\"id\":\"021e9234-5893-49b4-982e-cfe9a0aaddd9\",\"title\":\"Yes\",\"users\":0,\"channel_points\":0,\"top_predictors\":null,\"color\":\"BLUE\"},{\"id\":\"ded84c26-13cb-4b48-8cb5-5bae3ec3a66e\",\"title\":\"No\",\"users\":0,\"channel_points\":0,\"top_predictors\":null,\"color\":\"PINK\"}],\"prediction_window\":600,\"status\":\"ACTIVE\",\"created_at\":\"2021-04-28T16:03:06.320848689Z\",\"ended_at\":null,\"locked_at\":null}],\"pagination\":{}}")
+		_, err := fmt.Fprint(w, "{\"data\":[{\"id\":\"d6676d5c-c86e-44d2-bfc4-100fb48f0656\",\"broadcaster_id\":\"55696719\",\"broadcaster_name\":\"TwitchDev\",\"broadcaster_login\":\"twitchdev\",\"title\":\"Will there be any leaks today?\",\"winning_outcome_id\":null,\"outcomes\":[{\"id\":\"021e9234-5893-49b4-982e-cfe9a0aaddd9\",\"title\":\"Yes\",\"users\":0,\"channel_points\":0,\"top_predictors\":[{\"user_id\":\"141981764\",\"user_name\":\"TwitchDev\",\"user_login\":\"twitchdev\",\"channel_points_used\":500,\"channel_points_won\":0}],\"color\":\"BLUE\"},{\"id\":\"ded84c26-13cb-4b48-8cb5-5bae3ec3a66e\",\"title\":\"No\",\"users\":0,\"channel_points\":0,\"top_predictors\":null,\"color\":\"PINK\"}],\"prediction_window\":600,\"status\":\"ACTIVE\",\"created_at\":\"2021-04-28T16:03:06.320848689Z\",\"ended_at\":null,\"locked_at\":null}],\"pagination\":{}}")
 		require.NoError(t, err)
 	}))
 	defer ts.Close()
@@ -1803,8 +1815,11 @@ func TestClient_GetPredictionsReturns200(t *testing.T) {
 	require.Equal(t, "Will there be any leaks today?", predictions.Data[0].Title)
 	require.Zero(t, predictions.Data[0].WinningOutcomeId)
 	require.Len(t, predictions.Data[0].Outcomes, 2)
+	require.Equal(t, []*gotau.TopPredictor{{UserID: "141981764", UserName: "TwitchDev", UserLogin: "twitchdev",
+		ChannelPointsUsed: 500}}, predictions.Data[0].Outcomes[0].TopPredictors)
+	require.Nil(t, predictions.Data[0].Outcomes[1].TopPredictors)
 	require.Equal(t, 600, predictions.Data[0].PredictionWindow)
-	require.Equal(t, "ACTIVE", predictions.Data[0].Status)
+	require.Equal(t, PredictionActive, predictions.Data[0].Status)
 	require.Equal(t, 2021, predictions.Data[0].CreatedAt.Year())
 	require.Nil(t, predictions.Data[0].EndedAt)
 	require.Nil(t, predictions.Data[0].LockedAt)
@@ -2034,7 +2049,7 @@ func TestClient_GetStreamsReturns200(t *testing.T) {
 	require.Equal(t, "auronplay", streams.Data[0].UserName)
 	require.Equal(t, "494131", streams.Data[0].GameID)
 	require.Equal(t, "Little Nightmares", streams.Data[0].GameName)
-	require.Equal(t, "live", streams.Data[0].Type)
+	require.Equal(t, gotau.StreamLive, streams.Data[0].Type)
 	require.Equal(t, "hablamos y le damos a Little Nightmares 1", streams.Data[0].Title)
 	require.Equal(t, 78365, streams.Data[0].ViewerCount)
 	require.Equal(t, 2021, streams.Data[0].StartedAt.Year())
@@ -2106,7 +2121,7 @@ func TestClient_GetFollowedStreamsReturns200(t *testing.T) {
 	require.Equal(t, "AWS", streams.Data[0].UserName)
 	require.Equal(t, "417752", streams.Data[0].GameID)
 	require.Equal(t, "Talk Shows & Podcasts", streams.Data[0].GameName)
-	require.Equal(t, "live", streams.Data[0].Type)
+	require.Equal(t, gotau.StreamLive, streams.Data[0].Type)
 	require.Equal(t, "AWS Howdy Partner! Y'all welcome ExtraHop to the show!", streams.Data[0].Title)
 	require.Equal(t, 20, streams.Data[0].ViewerCount)
 	require.Equal(t, 2021, streams.Data[0].StartedAt.Year())
@@ -2247,7 +2262,7 @@ func TestClient_GetBroadcasterSubscriptionsReturns200(t *testing.T) {
 	require.Equal(t, "twitch", subs.Data[0].GifterLogin)
 	require.Equal(t, "Twitch", subs.Data[0].GifterName)
 	require.True(t, subs.Data[0].IsGift)
-	require.Equal(t, "1000", subs.Data[0].Tier)
+	require.Equal(t, gotau.SubTier1, subs.Data[0].Tier)
 	require.Equal(t, "Channel Subscription (twitchdev)", subs.Data[0].PlanName)
 	require.Equal(t, "527115020", subs.Data[0].UserID)
 	require.Equal(t, "twitchgaming", subs.Data[0].UserName)
@@ -2307,7 +2322,7 @@ func TestClient_CheckUserSubscriptionReturns200(t *testing.T) {
 	require.Equal(t, "149747285", subs.Data[0].BroadcasterId)
 	require.Equal(t, "TwitchPresents", subs.Data[0].BroadcasterName)
 	require.Equal(t, "twitchpresents", subs.Data[0].BroadcasterLogin)
-	require.Equal(t, "1000", subs.Data[0].Tier)
+	require.Equal(t, gotau.SubTier1, subs.Data[0].Tier)
 	require.False(t, subs.Data[0].IsGift)
 	require.Zero(t, subs.Data[0].GifterID)
 	require.Zero(t, subs.Data[0].GifterLogin)
@@ -3178,3 +3193,29 @@ func TestClient_GetChannelStreamScheduleAsICalReturnsError(t *testing.T) {
 	require.ErrorIs(t, err, gotau.BadRequestError{Err: "invalid request, broadcast can't be blank"})
 	require.Nil(t, schedule)
 }
+
+func TestClient_GetRequestWithContextCanceled(t *testing.T) {
+	block := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer ts.Close()
+	defer close(block)
+
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client, err := NewClient(host, portNum, "foo", false)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	users, err := client.GetTwitchUsersWithContext(ctx, []string{"wwsean08"}, nil)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Nil(t, users)
+}
@@ -3,20 +3,44 @@ package helix
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	gotau "github.com/Team-TAU/tau-client-go"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 )
 
 // Client for interacting with the TAU Helix pass thru, storing the important credential information.
 type Client struct {
-	hostname string
-	port     int
-	hasSSL   bool
-	token    string
+	hostname   string
+	port       int
+	hasSSL     bool
+	token      string
+	httpClient *http.Client
+	basePath   string
+	header     http.Header
+	logger     gotau.Logger
+
+	// lock guards the request scheduling settings below
+	lock        sync.RWMutex
+	limiter     *rateLimiter
+	retryPolicy *gotau.RetryPolicy
+}
+
+// defaultHTTPTimeout is the timeout of the http client used when one hasn't been provided.
+const defaultHTTPTimeout = 30 * time.Second
+
+var defaultHTTPClient = &http.Client{Timeout: defaultHTTPTimeout}
+
+func (c *Client) getHTTPClient() *http.Client {
+	if c.httpClient != nil {
+		return c.httpClient
+	}
+	return defaultHTTPClient
 }
 
 // NewClient will generate a new client for interacting with the twitch helix api using the TAU pass thru.  Currently
@@ -31,18 +55,96 @@ func NewClient(hostname string, port int, token string, hasSSL bool) (*Client, e
 	return client, nil
 }
 
-func (c *Client) helixRequest(endpoint string, params map[string][]string, body []byte, method string) ([]byte, error) {
+// NewClientWithOptions will generate a new client for interacting with the twitch helix api using the TAU pass thru,
+// configured by the same options used for the websocket client.  For example:
+//
+//	client, err := helix.NewClientWithOptions(gotau.WithURL("https://tau.example.com/prefix"), gotau.WithToken(token))
+func NewClientWithOptions(opts ...gotau.Option) (*Client, error) {
+	options, err := gotau.NewOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{
+		hostname:    options.Hostname,
+		port:        options.Port,
+		hasSSL:      options.HasSSL,
+		token:       options.Token,
+		httpClient:  options.HTTPClient,
+		basePath:    options.BasePath,
+		header:      options.Header,
+		logger:      options.Logger,
+		retryPolicy: options.RetryPolicy,
+	}
+	return client, nil
+}
+
+// SetRetryPolicy enables retrying requests that fail with a transient error using the supplied policy, passing nil
+// disables it, which is the default.  Rate limited requests are handled by the rate limiter instead, see
+// SetRateLimiter.
+func (c *Client) SetRetryPolicy(policy *gotau.RetryPolicy) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.retryPolicy = policy
+}
+
+func (c *Client) getRetryPolicy() *gotau.RetryPolicy {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.retryPolicy
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
+// helixRequest sends the request, retrying it according to the retry policy.
+func (c *Client) helixRequest(ctx context.Context, endpoint string, params map[string][]string, body []byte, method string) ([]byte, error) {
+	return c.getRetryPolicy().Do(ctx, method, func() ([]byte, error) {
+		return c.scheduleHelixRequest(ctx, endpoint, params, body, method)
+	})
+}
+
+// scheduleHelixRequest sends the request, going through the rate limit scheduler when it's enabled.
+func (c *Client) scheduleHelixRequest(ctx context.Context, endpoint string, params map[string][]string, body []byte,
+	method string) ([]byte, error) {
+	limiter := c.rateLimiter()
+	if limiter == nil {
+		return c.sendHelixRequest(ctx, endpoint, params, body, method, nil)
+	}
+	for retries := 0; ; retries++ {
+		err := limiter.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result, err := c.sendHelixRequest(ctx, endpoint, params, body, method, limiter)
+		var rlErr RateLimitError
+		if !errors.As(err, &rlErr) || retries >= limiter.config.MaxRetries {
+			return result, err
+		}
+		limiter.exhausted(rlErr.ResetTime())
+		c.logf("helix request %s %s was rate limited, retrying after the reset", method, endpoint)
+	}
+}
+
+func (c *Client) sendHelixRequest(ctx context.Context, endpoint string, params map[string][]string, body []byte, method string,
+	limiter *rateLimiter) ([]byte, error) {
 	protocol := "http"
 	if c.hasSSL {
 		protocol = "https"
 	}
-	endpointURL := fmt.Sprintf("%s://%s:%d/api/twitch/helix/%s/", protocol, c.hostname, c.port, endpoint)
-	httpClient := &http.Client{}
+	endpointURL := fmt.Sprintf("%s://%s:%d%s/api/twitch/helix/%s/", protocol, c.hostname, c.port, c.basePath, endpoint)
 	buffer := bytes.NewBuffer(body)
-	request, err := http.NewRequest(method, endpointURL, buffer)
+	request, err := http.NewRequestWithContext(ctx, method, endpointURL, buffer)
 	if err != nil {
 		return nil, err
 	}
+	for key, values := range c.header {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
 	request.Header.Add("Authorization", fmt.Sprintf("Token %s", c.token))
 	request.Header.Add("Content-Type", "application/json")
 	_, err = request.URL.Parse(endpointURL)
@@ -58,20 +160,26 @@ func (c *Client) helixRequest(endpoint string, params map[string][]string, body
 	}
 	request.URL.RawQuery = q.Encode()
 
-	response, err := httpClient.Do(request)
+	response, err := c.getHTTPClient().Do(request)
 	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
+	if limiter != nil {
+		limiter.update(response.Header)
+	}
 	if response.StatusCode >= 200 && response.StatusCode < 300 {
 		body, err := ioutil.ReadAll(response.Body)
 		return body, err
 	}
-	if response.StatusCode == 401 {
-		return nil, gotau.AuthorizationError{}
-	} else if response.StatusCode == 429 {
+	c.logf("helix request %s %s failed with status %d", method, endpoint, response.StatusCode)
+	body, _ = ioutil.ReadAll(response.Body)
+	apiErr := newAPIError(response.StatusCode, request.URL.String(), body)
+	if response.StatusCode == 429 {
 		resetEpoch := response.Header.Get("Ratelimit-Reset")
 		rlErr := RateLimitError{
 			err: "rate limited: received http 429",
+			api: &apiErr,
 		}
 		if resetEpoch != "" {
 			epoch, err := strconv.ParseInt(resetEpoch, 10, 64)
@@ -82,13 +190,6 @@ func (c *Client) helixRequest(endpoint string, params map[string][]string, body
 			rlErr.reset = &reset
 		}
 		return nil, rlErr
-	} else {
-		body, _ := ioutil.ReadAll(response.Body)
-		err = gotau.GenericError{
-			Err:  fmt.Sprintf("response code %d: %s", response.StatusCode, body),
-			Body: body,
-			Code: response.StatusCode,
-		}
-		return nil, err
 	}
+	return nil, apiErr
 }
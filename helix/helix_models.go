@@ -1,6 +1,9 @@
 package helix
 
-import "time"
+import (
+	gotau "github.com/Team-TAU/tau-client-go"
+	"time"
+)
 
 // TwitchPagination represents pagination data from twitch on endpoints that support multi-paged responses.
 type TwitchPagination struct {
@@ -129,26 +132,15 @@ type ExtensionTransactions struct {
 }
 
 // CustomRewardImage represents the various images associated with a custom reward.
-type CustomRewardImage struct {
-	Url1X string `json:"url_1x"`
-	Url2X string `json:"url_2x"`
-	Url4X string `json:"url_4x"`
-}
+type CustomRewardImage = gotau.CustomRewardImage
 
 // CustomRewards represents the response from Get Custom Rewards, see https://dev.twitch.tv/docs/api/reference#get-custom-reward
 type CustomRewards struct {
 	Data []struct {
-		BroadcasterName     string             `json:"broadcaster_name"`
-		BroadcasterLogin    string             `json:"broadcaster_login"`
-		BroadcasterId       string             `json:"broadcaster_id"`
-		ID                  string             `json:"id"`
-		Image               *CustomRewardImage `json:"image"`
-		BackgroundColor     string             `json:"background_color"`
-		IsEnabled           bool               `json:"is_enabled"`
-		Cost                int                `json:"cost"`
-		Title               string             `json:"title"`
-		Prompt              string             `json:"prompt"`
-		IsUserInputRequired bool               `json:"is_user_input_required"`
+		gotau.CustomReward
+		BroadcasterName     string `json:"broadcaster_name"`
+		BroadcasterLogin    string `json:"broadcaster_login"`
+		BroadcasterId       string `json:"broadcaster_id"`
 		MaxPerStreamSetting struct {
 			IsEnabled    bool `json:"is_enabled"`
 			MaxPerStream int  `json:"max_per_stream"`
@@ -161,34 +153,23 @@ type CustomRewards struct {
 			IsEnabled             bool `json:"is_enabled"`
 			GlobalCooldownSeconds int  `json:"global_cooldown_seconds"`
 		} `json:"global_cooldown_setting"`
-		IsPaused                          bool               `json:"is_paused"`
-		IsInStock                         bool               `json:"is_in_stock"`
-		DefaultImage                      *CustomRewardImage `json:"default_image"`
-		ShouldRedemptionsSkipRequestQueue bool               `json:"should_redemptions_skip_request_queue"`
-		RedemptionsRedeemedCurrentStream  int                `json:"redemptions_redeemed_current_stream"`
-		CooldownExpiresAt                 *time.Time         `json:"cooldown_expires_at"`
 	} `json:"data"`
 }
 
 // CustomRewardRedemptions represents the response from Get Custom Reward Redemption, see https://dev.twitch.tv/docs/api/reference#get-custom-reward-redemption
 type CustomRewardRedemptions struct {
 	Data []struct {
-		BroadcasterName  string    `json:"broadcaster_name"`
-		BroadcasterLogin string    `json:"broadcaster_login"`
-		BroadcasterID    string    `json:"broadcaster_id"`
-		ID               string    `json:"id"`
-		UserLogin        string    `json:"user_login"`
-		UserID           string    `json:"user_id"`
-		UserName         string    `json:"user_name"`
-		UserInput        string    `json:"user_input"`
-		Status           string    `json:"status"`
-		RedeemedAt       time.Time `json:"redeemed_at"`
-		Reward           struct {
-			ID     string `json:"id"`
-			Title  string `json:"title"`
-			Prompt string `json:"prompt"`
-			Cost   int    `json:"cost"`
-		} `json:"reward"`
+		BroadcasterName  string                 `json:"broadcaster_name"`
+		BroadcasterLogin string                 `json:"broadcaster_login"`
+		BroadcasterID    string                 `json:"broadcaster_id"`
+		ID               string                 `json:"id"`
+		UserLogin        string                 `json:"user_login"`
+		UserID           string                 `json:"user_id"`
+		UserName         string                 `json:"user_name"`
+		UserInput        string                 `json:"user_input"`
+		Status           gotau.RedemptionStatus `json:"status"`
+		RedeemedAt       time.Time              `json:"redeemed_at"`
+		Reward           gotau.RedemptionReward `json:"reward"`
 	} `json:"data"`
 	Pagination *TwitchPagination `json:"pagination"`
 }
@@ -319,16 +300,16 @@ type HypeTrainEvents struct {
 			Goal             int       `json:"goal"`
 			ID               string    `json:"id"`
 			LastContribution struct {
-				Total int    `json:"total"`
-				Type  string `json:"type"`
-				User  string `json:"user"`
+				Total int                    `json:"total"`
+				Type  gotau.ContributionType `json:"type"`
+				User  string                 `json:"user"`
 			} `json:"last_contribution"`
 			Level            int       `json:"level"`
 			StartedAt        time.Time `json:"started_at"`
 			TopContributions []struct {
-				Total int    `json:"total"`
-				Type  string `json:"type"`
-				User  string `json:"user"`
+				Total int                    `json:"total"`
+				Type  gotau.ContributionType `json:"type"`
+				User  string                 `json:"user"`
 			} `json:"top_contributions"`
 			Total int `json:"total"`
 		} `json:"event_data"`
@@ -400,61 +381,45 @@ type ModeratorEvents struct {
 // Polls represents the response from Get Polls, see https://dev.twitch.tv/docs/api/reference#get-polls
 type Polls struct {
 	Data []struct {
-		ID               string `json:"id"`
-		BroadcasterID    string `json:"broadcaster_id"`
-		BroadcasterName  string `json:"broadcaster_name"`
-		BroadcasterLogin string `json:"broadcaster_login"`
-		Title            string `json:"title"`
-		Choices          []struct {
-			ID                 string `json:"id"`
-			Title              string `json:"title"`
-			Votes              int    `json:"votes"`
-			ChannelPointsVotes int    `json:"channel_points_votes"`
-			BitsVotes          int    `json:"bits_votes"`
-		} `json:"choices"`
-		BitsVotingEnabled          bool       `json:"bits_voting_enabled"`
-		BitsPerVote                int        `json:"bits_per_vote"`
-		ChannelPointsVotingEnabled bool       `json:"channel_points_voting_enabled"`
-		ChannelPointsPerVote       int        `json:"channel_points_per_vote"`
-		Status                     string     `json:"status"`
-		Duration                   int        `json:"duration"`
-		StartedAt                  time.Time  `json:"started_at"`
-		EndedAt                    *time.Time `json:"ended_at"`
+		ID                         string             `json:"id"`
+		BroadcasterID              string             `json:"broadcaster_id"`
+		BroadcasterName            string             `json:"broadcaster_name"`
+		BroadcasterLogin           string             `json:"broadcaster_login"`
+		Title                      string             `json:"title"`
+		Choices                    []gotau.PollChoice `json:"choices"`
+		BitsVotingEnabled          bool               `json:"bits_voting_enabled"`
+		BitsPerVote                int                `json:"bits_per_vote"`
+		ChannelPointsVotingEnabled bool               `json:"channel_points_voting_enabled"`
+		ChannelPointsPerVote       int                `json:"channel_points_per_vote"`
+		Status                     gotau.PollStatus   `json:"status"`
+		Duration                   int                `json:"duration"`
+		StartedAt                  time.Time          `json:"started_at"`
+		EndedAt                    *time.Time         `json:"ended_at"`
 	} `json:"data"`
 	Pagination *TwitchPagination `json:"pagination"`
 }
 
-// TopPredictors represents users who bet the most on their Predictions and won
-type TopPredictors struct {
-	UserID            string `json:"id"`
-	UserName          string `json:"name"`
-	UserLogin         string `json:"login"`
-	ChannelPointsUsed int    `json:"channel_points_used"`
-	ChannelPointsWon  int    `json:"channel_points_won"`
-}
+// TopPredictors represents users who bet the most on their Predictions and won.
+//
+// Deprecated: use gotau.TopPredictor, which Predictions uses and which has twitch's user_id, user_name and user_login
+// field names rather than id, name and login.
+type TopPredictors = gotau.TopPredictor
 
 // Predictions represents the response from Get Predictions, see https://dev.twitch.tv/docs/api/reference#get-predictions
 type Predictions struct {
 	Data []struct {
-		ID               string `json:"id"`
-		BroadcasterID    string `json:"broadcaster_id"`
-		BroadcasterName  string `json:"broadcaster_name"`
-		BroadcasterLogin string `json:"broadcaster_login"`
-		Title            string `json:"title"`
-		WinningOutcomeId string `json:"winning_outcome_id"`
-		Outcomes         []struct {
-			ID            string           `json:"id"`
-			Title         string           `json:"title"`
-			Users         int              `json:"users"`
-			ChannelPoints int              `json:"channel_points"`
-			TopPredictors []*TopPredictors `json:"top_predictors"`
-			Color         string           `json:"color"`
-		} `json:"outcomes"`
-		PredictionWindow int        `json:"prediction_window"`
-		Status           string     `json:"status"`
-		CreatedAt        time.Time  `json:"created_at"`
-		EndedAt          *time.Time `json:"ended_at"`
-		LockedAt         *time.Time `json:"locked_at"`
+		ID               string                    `json:"id"`
+		BroadcasterID    string                    `json:"broadcaster_id"`
+		BroadcasterName  string                    `json:"broadcaster_name"`
+		BroadcasterLogin string                    `json:"broadcaster_login"`
+		Title            string                    `json:"title"`
+		WinningOutcomeId string                    `json:"winning_outcome_id"`
+		Outcomes         []gotau.PredictionOutcome `json:"outcomes"`
+		PredictionWindow int                       `json:"prediction_window"`
+		Status           gotau.PredictionStatus    `json:"status"`
+		CreatedAt        time.Time                 `json:"created_at"`
+		EndedAt          *time.Time                `json:"ended_at"`
+		LockedAt         *time.Time                `json:"locked_at"`
 	} `json:"data"`
 	Pagination *TwitchPagination `json:"pagination"`
 }
@@ -487,20 +452,20 @@ type StreamKey struct {
 // Streams represents the response from Get Streams and Get Followed Streams, see https://dev.twitch.tv/docs/api/reference#get-streams
 type Streams struct {
 	Data []struct {
-		ID           string    `json:"id"`
-		UserID       string    `json:"user_id"`
-		UserLogin    string    `json:"user_login"`
-		UserName     string    `json:"user_name"`
-		GameID       string    `json:"game_id"`
-		GameName     string    `json:"game_name"`
-		Type         string    `json:"type"`
-		Title        string    `json:"title"`
-		ViewerCount  int       `json:"viewer_count"`
-		StartedAt    time.Time `json:"started_at"`
-		Language     string    `json:"language"`
-		ThumbnailUrl string    `json:"thumbnail_url"`
-		TagIDs       []string  `json:"tag_ids"`
-		IsMature     bool      `json:"is_mature"`
+		ID           string           `json:"id"`
+		UserID       string           `json:"user_id"`
+		UserLogin    string           `json:"user_login"`
+		UserName     string           `json:"user_name"`
+		GameID       string           `json:"game_id"`
+		GameName     string           `json:"game_name"`
+		Type         gotau.StreamType `json:"type"`
+		Title        string           `json:"title"`
+		ViewerCount  int              `json:"viewer_count"`
+		StartedAt    time.Time        `json:"started_at"`
+		Language     string           `json:"language"`
+		ThumbnailUrl string           `json:"thumbnail_url"`
+		TagIDs       []string         `json:"tag_ids"`
+		IsMature     bool             `json:"is_mature"`
 	} `json:"data"`
 	Pagination *TwitchPagination `json:"pagination"`
 }
@@ -528,18 +493,18 @@ type StreamMarkers struct {
 // Subscriptions represents the response from Get Broadcaster Subscriptions, see https://dev.twitch.tv/docs/api/reference#get-broadcaster-subscriptions
 type Subscriptions struct {
 	Data []struct {
-		BroadcasterID    string `json:"broadcaster_id"`
-		BroadcasterLogin string `json:"broadcaster_login"`
-		BroadcasterName  string `json:"broadcaster_name"`
-		GifterID         string `json:"gifter_id"`
-		GifterLogin      string `json:"gifter_login"`
-		GifterName       string `json:"gifter_name"`
-		IsGift           bool   `json:"is_gift"`
-		Tier             string `json:"tier"`
-		PlanName         string `json:"plan_name"`
-		UserID           string `json:"user_id"`
-		UserName         string `json:"user_name"`
-		UserLogin        string `json:"user_login"`
+		BroadcasterID    string        `json:"broadcaster_id"`
+		BroadcasterLogin string        `json:"broadcaster_login"`
+		BroadcasterName  string        `json:"broadcaster_name"`
+		GifterID         string        `json:"gifter_id"`
+		GifterLogin      string        `json:"gifter_login"`
+		GifterName       string        `json:"gifter_name"`
+		IsGift           bool          `json:"is_gift"`
+		Tier             gotau.SubTier `json:"tier"`
+		PlanName         string        `json:"plan_name"`
+		UserID           string        `json:"user_id"`
+		UserName         string        `json:"user_name"`
+		UserLogin        string        `json:"user_login"`
 	} `json:"data"`
 	Pagination *TwitchPagination `json:"pagination"`
 	Total      int               `json:"total"`
@@ -548,14 +513,14 @@ type Subscriptions struct {
 // UserSubscriptions represents the response from Check User Subscription, see https://dev.twitch.tv/docs/api/reference#check-user-subscription
 type UserSubscriptions struct {
 	Data []struct {
-		BroadcasterId    string `json:"broadcaster_id"`
-		BroadcasterName  string `json:"broadcaster_name"`
-		BroadcasterLogin string `json:"broadcaster_login"`
-		GifterID         string `json:"gifter_id"`
-		GifterName       string `json:"gifter_name"`
-		GifterLogin      string `json:"gifter_login"`
-		IsGift           bool   `json:"is_gift"`
-		Tier             string `json:"tier"`
+		BroadcasterId    string        `json:"broadcaster_id"`
+		BroadcasterName  string        `json:"broadcaster_name"`
+		BroadcasterLogin string        `json:"broadcaster_login"`
+		GifterID         string        `json:"gifter_id"`
+		GifterName       string        `json:"gifter_name"`
+		GifterLogin      string        `json:"gifter_login"`
+		IsGift           bool          `json:"is_gift"`
+		Tier             gotau.SubTier `json:"tier"`
 	} `json:"data"`
 }
 
@@ -1,8 +1,11 @@
 package helix
 
 import (
+	gotau "github.com/Team-TAU/tau-client-go"
 	"github.com/stretchr/testify/require"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestNewClientReturnsClient(t *testing.T) {
@@ -14,3 +17,52 @@ func TestNewClientReturnsClient(t *testing.T) {
 	require.Equal(t, "abcdefg", client.token)
 	require.True(t, client.hasSSL)
 }
+
+func TestNewClientWithOptions(t *testing.T) {
+	client, err := NewClientWithOptions(gotau.WithURL("https://tau.example.com/prefix"), gotau.WithToken("abcdefg"))
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	require.Equal(t, "tau.example.com", client.hostname)
+	require.Equal(t, 443, client.port)
+	require.Equal(t, "abcdefg", client.token)
+	require.Equal(t, "/prefix", client.basePath)
+	require.True(t, client.hasSSL)
+
+	_, err = NewClientWithOptions(gotau.WithToken("abcdefg"))
+	require.Error(t, err)
+}
+
+func TestClient_SetRetryPolicy(t *testing.T) {
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{status: http.StatusServiceUnavailable},
+		{},
+	})
+	defer done()
+
+	policy := gotau.DefaultRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	client.SetRetryPolicy(policy)
+
+	body, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":[]}`, string(body))
+	require.Equal(t, 2, *requests)
+
+	_, err = client.PostRequest("users", nil, nil)
+	require.Error(t, err)
+	require.Equal(t, 3, *requests)
+
+	client.SetRetryPolicy(nil)
+	_, err = client.GetRequest("users", nil)
+	require.NoError(t, err)
+	_, err = client.GetRequest("users", nil)
+	require.Error(t, err)
+	require.Equal(t, 5, *requests)
+}
+
+func TestNewClientWithOptionsRetryPolicy(t *testing.T) {
+	policy := gotau.DefaultRetryPolicy()
+	client, err := NewClientWithOptions(gotau.WithHost("tau.example.com", 443), gotau.WithRetryPolicy(policy))
+	require.NoError(t, err)
+	require.Equal(t, policy, client.getRetryPolicy())
+}
@@ -0,0 +1,1008 @@
+package helix
+
+import (
+	"context"
+	gotau "github.com/Team-TAU/tau-client-go"
+	"time"
+)
+
+// GetCustomRewardRedemptionPages calls fn with each page of the reward's redemptions, starting at cursor.
+func (c *Client) GetCustomRewardRedemptionPages(ctx context.Context, broadcasterID string, rewardID string,
+	redemptionID []string, status gotau.RedemptionStatus, sort string, cursor string, resultCount int,
+	fn func(page *CustomRewardRedemptions) error) error {
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetCustomRewardRedemptionWithContext(ctx, broadcasterID, rewardID, redemptionID, status, sort,
+			next, resultCount)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetCustomRewardRedemptionAll collects up to limit of the reward's redemptions from every page into one response, or
+// all of them when limit is 0 or less.
+func (c *Client) GetCustomRewardRedemptionAll(ctx context.Context, broadcasterID string, rewardID string,
+	redemptionID []string, status gotau.RedemptionStatus, sort string, cursor string, resultCount int,
+	limit int) (*CustomRewardRedemptions, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *CustomRewardRedemptions) error {
+		return collector.add(page)
+	}
+	err := c.GetCustomRewardRedemptionPages(ctx, broadcasterID, rewardID, redemptionID, status, sort, cursor,
+		resultCount, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*CustomRewardRedemptions)
+	return all, nil
+}
+
+// GetClipsByBroadcasterPages calls fn with each page of the broadcaster's clips, starting at after, or paging backwards
+// from before when it is set.
+func (c *Client) GetClipsByBroadcasterPages(ctx context.Context, broadcasterID, after, before string, startedAt,
+	endedAt *time.Time, count int, fn func(page *Clips) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetClipsByBroadcasterWithContext(ctx, broadcasterID, pageAfter, pageBefore, startedAt, endedAt,
+			count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetClipsByBroadcasterAll collects up to limit of the broadcaster's clips from every page into one response, or all of
+// them when limit is 0 or less.
+func (c *Client) GetClipsByBroadcasterAll(ctx context.Context, broadcasterID, after, before string, startedAt,
+	endedAt *time.Time, count int, limit int) (*Clips, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Clips) error {
+		return collector.add(page)
+	}
+	err := c.GetClipsByBroadcasterPages(ctx, broadcasterID, after, before, startedAt, endedAt, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Clips)
+	return all, nil
+}
+
+// GetClipsByGamePages calls fn with each page of the game's clips, starting at after, or paging backwards from before
+// when it is set.
+func (c *Client) GetClipsByGamePages(ctx context.Context, gameID, after, before string, startedAt, endedAt *time.Time,
+	count int, fn func(page *Clips) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetClipsByGameWithContext(ctx, gameID, pageAfter, pageBefore, startedAt, endedAt, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetClipsByGameAll collects up to limit of the game's clips from every page into one response, or all of them when
+// limit is 0 or less.
+func (c *Client) GetClipsByGameAll(ctx context.Context, gameID, after, before string, startedAt, endedAt *time.Time,
+	count int, limit int) (*Clips, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Clips) error {
+		return collector.add(page)
+	}
+	err := c.GetClipsByGamePages(ctx, gameID, after, before, startedAt, endedAt, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Clips)
+	return all, nil
+}
+
+// GetClipsByIDPages calls fn with each page of the clips, starting at after, or paging backwards from before when it is
+// set.
+func (c *Client) GetClipsByIDPages(ctx context.Context, clipID []string, after, before string, startedAt,
+	endedAt *time.Time, count int, fn func(page *Clips) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetClipsByIDWithContext(ctx, clipID, pageAfter, pageBefore, startedAt, endedAt, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetClipsByIDAll collects up to limit of the clips from every page into one response, or all of them when limit is 0
+// or less.
+func (c *Client) GetClipsByIDAll(ctx context.Context, clipID []string, after, before string, startedAt,
+	endedAt *time.Time, count int, limit int) (*Clips, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Clips) error {
+		return collector.add(page)
+	}
+	err := c.GetClipsByIDPages(ctx, clipID, after, before, startedAt, endedAt, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Clips)
+	return all, nil
+}
+
+// GetTopGamesPages calls fn with each page of the top games, starting at after, or paging backwards from before when it
+// is set.
+func (c *Client) GetTopGamesPages(ctx context.Context, before, after string, count int,
+	fn func(page *Games) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetTopGamesWithContext(ctx, pageBefore, pageAfter, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetTopGamesAll collects up to limit of the top games from every page into one response, or all of them when limit is
+// 0 or less.
+func (c *Client) GetTopGamesAll(ctx context.Context, before, after string, count int, limit int) (*Games, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Games) error {
+		return collector.add(page)
+	}
+	err := c.GetTopGamesPages(ctx, before, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Games)
+	return all, nil
+}
+
+// GetHypeTrainEventsPages calls fn with each page of the broadcaster's hype train events, starting at cursor.
+func (c *Client) GetHypeTrainEventsPages(ctx context.Context, broadcasterID string, count int, id, cursor string,
+	fn func(page *HypeTrainEvents) error) error {
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetHypeTrainEventsWithContext(ctx, broadcasterID, count, id, next)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetHypeTrainEventsAll collects up to limit of the broadcaster's hype train events from every page into one response,
+// or all of them when limit is 0 or less.
+func (c *Client) GetHypeTrainEventsAll(ctx context.Context, broadcasterID string, count int, id, cursor string,
+	limit int) (*HypeTrainEvents, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *HypeTrainEvents) error {
+		return collector.add(page)
+	}
+	err := c.GetHypeTrainEventsPages(ctx, broadcasterID, count, id, cursor, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*HypeTrainEvents)
+	return all, nil
+}
+
+// GetBannedEventsPages calls fn with each page of the broadcaster's ban events, starting at after.
+func (c *Client) GetBannedEventsPages(ctx context.Context, broadcasterID, userID, after string, count int,
+	fn func(page *BannedEvents) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetBannedEventsWithContext(ctx, broadcasterID, userID, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetBannedEventsAll collects up to limit of the broadcaster's ban events from every page into one response, or all of
+// them when limit is 0 or less.
+func (c *Client) GetBannedEventsAll(ctx context.Context, broadcasterID, userID, after string, count int,
+	limit int) (*BannedEvents, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *BannedEvents) error {
+		return collector.add(page)
+	}
+	err := c.GetBannedEventsPages(ctx, broadcasterID, userID, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*BannedEvents)
+	return all, nil
+}
+
+// GetModeratorsPages calls fn with each page of the broadcaster's moderators, starting at after.
+func (c *Client) GetModeratorsPages(ctx context.Context, broadcasterID string, userIDs []string, after string,
+	count int, fn func(page *Moderators) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetModeratorsWithContext(ctx, broadcasterID, userIDs, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetModeratorsAll collects up to limit of the broadcaster's moderators from every page into one response, or all of
+// them when limit is 0 or less.
+func (c *Client) GetModeratorsAll(ctx context.Context, broadcasterID string, userIDs []string, after string, count int,
+	limit int) (*Moderators, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Moderators) error {
+		return collector.add(page)
+	}
+	err := c.GetModeratorsPages(ctx, broadcasterID, userIDs, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Moderators)
+	return all, nil
+}
+
+// GetModeratorEventsPages calls fn with each page of the broadcaster's moderator events, starting at after.
+func (c *Client) GetModeratorEventsPages(ctx context.Context, broadcasterID string, userIDs []string, after string,
+	count int, fn func(page *ModeratorEvents) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetModeratorEventsWithContext(ctx, broadcasterID, userIDs, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetModeratorEventsAll collects up to limit of the broadcaster's moderator events from every page into one response,
+// or all of them when limit is 0 or less.
+func (c *Client) GetModeratorEventsAll(ctx context.Context, broadcasterID string, userIDs []string, after string,
+	count int, limit int) (*ModeratorEvents, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *ModeratorEvents) error {
+		return collector.add(page)
+	}
+	err := c.GetModeratorEventsPages(ctx, broadcasterID, userIDs, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*ModeratorEvents)
+	return all, nil
+}
+
+// GetPollsPages calls fn with each page of the broadcaster's polls, starting at after.
+func (c *Client) GetPollsPages(ctx context.Context, broadcasterID string, IDs []string, after string, count int,
+	fn func(page *Polls) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetPollsWithContext(ctx, broadcasterID, IDs, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetPollsAll collects up to limit of the broadcaster's polls from every page into one response, or all of them when
+// limit is 0 or less.
+func (c *Client) GetPollsAll(ctx context.Context, broadcasterID string, IDs []string, after string, count int,
+	limit int) (*Polls, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Polls) error {
+		return collector.add(page)
+	}
+	err := c.GetPollsPages(ctx, broadcasterID, IDs, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Polls)
+	return all, nil
+}
+
+// GetPredictionsPages calls fn with each page of the broadcaster's predictions, starting at after.
+func (c *Client) GetPredictionsPages(ctx context.Context, broadcasterID string, IDs []string, after string, count int,
+	fn func(page *Predictions) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetPredictionsWithContext(ctx, broadcasterID, IDs, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetPredictionsAll collects up to limit of the broadcaster's predictions from every page into one response, or all of
+// them when limit is 0 or less.
+func (c *Client) GetPredictionsAll(ctx context.Context, broadcasterID string, IDs []string, after string, count int,
+	limit int) (*Predictions, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Predictions) error {
+		return collector.add(page)
+	}
+	err := c.GetPredictionsPages(ctx, broadcasterID, IDs, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Predictions)
+	return all, nil
+}
+
+// SearchCategoriesPages calls fn with each page of the categories matching query, starting at after.
+func (c *Client) SearchCategoriesPages(ctx context.Context, query, after string, count int,
+	fn func(page *Games) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.SearchCategoriesWithContext(ctx, query, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// SearchCategoriesAll collects up to limit of the categories matching query from every page into one response, or all
+// of them when limit is 0 or less.
+func (c *Client) SearchCategoriesAll(ctx context.Context, query, after string, count int, limit int) (*Games, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Games) error {
+		return collector.add(page)
+	}
+	err := c.SearchCategoriesPages(ctx, query, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Games)
+	return all, nil
+}
+
+// SearchChannelsPages calls fn with each page of the channels matching query, starting at after.
+func (c *Client) SearchChannelsPages(ctx context.Context, query, after string, count int, liveOnly bool,
+	fn func(page *ChannelSearchResults) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.SearchChannelsWithContext(ctx, query, next, count, liveOnly)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// SearchChannelsAll collects up to limit of the channels matching query from every page into one response, or all of
+// them when limit is 0 or less.
+func (c *Client) SearchChannelsAll(ctx context.Context, query, after string, count int, liveOnly bool,
+	limit int) (*ChannelSearchResults, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *ChannelSearchResults) error {
+		return collector.add(page)
+	}
+	err := c.SearchChannelsPages(ctx, query, after, count, liveOnly, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*ChannelSearchResults)
+	return all, nil
+}
+
+// GetStreamsPages calls fn with each page of the live streams, starting at after, or paging backwards from before when
+// it is set.
+func (c *Client) GetStreamsPages(ctx context.Context, before, after string, count int, gameIDs []string,
+	languages []string, userIDs []string, userLogins []string, fn func(page *Streams) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetStreamsWithContext(ctx, pageBefore, pageAfter, count, gameIDs, languages, userIDs, userLogins)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetStreamsAll collects up to limit of the live streams from every page into one response, or all of them when limit
+// is 0 or less.
+func (c *Client) GetStreamsAll(ctx context.Context, before, after string, count int, gameIDs []string,
+	languages []string, userIDs []string, userLogins []string, limit int) (*Streams, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Streams) error {
+		return collector.add(page)
+	}
+	err := c.GetStreamsPages(ctx, before, after, count, gameIDs, languages, userIDs, userLogins, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Streams)
+	return all, nil
+}
+
+// GetFollowedStreamsPages calls fn with each page of the live streams the user follows, starting at after.
+func (c *Client) GetFollowedStreamsPages(ctx context.Context, userID, after string, count int,
+	fn func(page *Streams) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetFollowedStreamsWithContext(ctx, userID, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetFollowedStreamsAll collects up to limit of the live streams the user follows from every page into one response, or
+// all of them when limit is 0 or less.
+func (c *Client) GetFollowedStreamsAll(ctx context.Context, userID, after string, count int, limit int) (*Streams,
+	error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Streams) error {
+		return collector.add(page)
+	}
+	err := c.GetFollowedStreamsPages(ctx, userID, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Streams)
+	return all, nil
+}
+
+// GetStreamMarkersPages calls fn with each page of the stream markers, starting at after, or paging backwards from
+// before when it is set.
+func (c *Client) GetStreamMarkersPages(ctx context.Context, userID, videoID, before, after string, count int,
+	fn func(page *StreamMarkers) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetStreamMarkersWithContext(ctx, userID, videoID, pageBefore, pageAfter, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetStreamMarkersAll collects up to limit of the stream markers from every page into one response, or all of them when
+// limit is 0 or less.
+func (c *Client) GetStreamMarkersAll(ctx context.Context, userID, videoID, before, after string, count int,
+	limit int) (*StreamMarkers, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *StreamMarkers) error {
+		return collector.add(page)
+	}
+	err := c.GetStreamMarkersPages(ctx, userID, videoID, before, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*StreamMarkers)
+	return all, nil
+}
+
+// GetBroadcasterSubscriptionsPages calls fn with each page of the broadcaster's subscriptions, starting at after.
+func (c *Client) GetBroadcasterSubscriptionsPages(ctx context.Context, broadcasterID string, userIDs []string,
+	after string, count int, fn func(page *Subscriptions) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetBroadcasterSubscriptionsWithContext(ctx, broadcasterID, userIDs, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetBroadcasterSubscriptionsAll collects up to limit of the broadcaster's subscriptions from every page into one
+// response, or all of them when limit is 0 or less.
+func (c *Client) GetBroadcasterSubscriptionsAll(ctx context.Context, broadcasterID string, userIDs []string,
+	after string, count int, limit int) (*Subscriptions, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Subscriptions) error {
+		return collector.add(page)
+	}
+	err := c.GetBroadcasterSubscriptionsPages(ctx, broadcasterID, userIDs, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Subscriptions)
+	return all, nil
+}
+
+// GetAllStreamTagsPages calls fn with each page of the stream tags, starting at after.
+func (c *Client) GetAllStreamTagsPages(ctx context.Context, after string, count int, tagIDs []string,
+	fn func(page *StreamTags) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetAllStreamTagsWithContext(ctx, next, count, tagIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetAllStreamTagsAll collects up to limit of the stream tags from every page into one response, or all of them when
+// limit is 0 or less.
+func (c *Client) GetAllStreamTagsAll(ctx context.Context, after string, count int, tagIDs []string,
+	limit int) (*StreamTags, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *StreamTags) error {
+		return collector.add(page)
+	}
+	err := c.GetAllStreamTagsPages(ctx, after, count, tagIDs, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*StreamTags)
+	return all, nil
+}
+
+// GetUsersFollowsPages calls fn with each page of the follows, starting at after.
+func (c *Client) GetUsersFollowsPages(ctx context.Context, fromID, toID, after string, count int,
+	fn func(page *UserFollows) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetUsersFollowsWithContext(ctx, fromID, toID, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetUsersFollowsAll collects up to limit of the follows from every page into one response, or all of them when limit
+// is 0 or less.
+func (c *Client) GetUsersFollowsAll(ctx context.Context, fromID, toID, after string, count int,
+	limit int) (*UserFollows, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *UserFollows) error {
+		return collector.add(page)
+	}
+	err := c.GetUsersFollowsPages(ctx, fromID, toID, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*UserFollows)
+	return all, nil
+}
+
+// GetUsersBlockListPages calls fn with each page of the broadcaster's block list, starting at after.
+func (c *Client) GetUsersBlockListPages(ctx context.Context, broadcasterID, after string, count int,
+	fn func(page *UserBlockList) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetUsersBlockListWithContext(ctx, broadcasterID, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetUsersBlockListAll collects up to limit of the broadcaster's block list from every page into one response, or all
+// of them when limit is 0 or less.
+func (c *Client) GetUsersBlockListAll(ctx context.Context, broadcasterID, after string, count int,
+	limit int) (*UserBlockList, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *UserBlockList) error {
+		return collector.add(page)
+	}
+	err := c.GetUsersBlockListPages(ctx, broadcasterID, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*UserBlockList)
+	return all, nil
+}
+
+// GetVideosByUserPages calls fn with each page of the user's videos, starting at after, or paging backwards from before
+// when it is set.
+func (c *Client) GetVideosByUserPages(ctx context.Context, userID, before, after, language string, count int, period,
+	sort, _type string, fn func(page *Video) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetVideosByUserWithContext(ctx, userID, pageBefore, pageAfter, language, count, period, sort,
+			_type)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetVideosByUserAll collects up to limit of the user's videos from every page into one response, or all of them when
+// limit is 0 or less.
+func (c *Client) GetVideosByUserAll(ctx context.Context, userID, before, after, language string, count int, period,
+	sort, _type string, limit int) (*Video, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Video) error {
+		return collector.add(page)
+	}
+	err := c.GetVideosByUserPages(ctx, userID, before, after, language, count, period, sort, _type, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Video)
+	return all, nil
+}
+
+// GetVideosByGamePages calls fn with each page of the game's videos, starting at after, or paging backwards from before
+// when it is set.
+func (c *Client) GetVideosByGamePages(ctx context.Context, gameID, before, after, language string, count int, period,
+	sort, _type string, fn func(page *Video) error) error {
+	backwards := before != ""
+	cursor := after
+	if backwards {
+		cursor = before
+	}
+	return walkPages(ctx, cursor, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		pageAfter, pageBefore := pageCursors(backwards, next)
+		page, err := c.GetVideosByGameWithContext(ctx, gameID, pageBefore, pageAfter, language, count, period, sort,
+			_type)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetVideosByGameAll collects up to limit of the game's videos from every page into one response, or all of them when
+// limit is 0 or less.
+func (c *Client) GetVideosByGameAll(ctx context.Context, gameID, before, after, language string, count int, period,
+	sort, _type string, limit int) (*Video, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *Video) error {
+		return collector.add(page)
+	}
+	err := c.GetVideosByGamePages(ctx, gameID, before, after, language, count, period, sort, _type, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*Video)
+	return all, nil
+}
+
+// GetWebhookSubscriptionsPages calls fn with each page of the webhook subscriptions, starting at after.
+func (c *Client) GetWebhookSubscriptionsPages(ctx context.Context, after string, count int,
+	fn func(page *WebhookSubscriptions) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetWebhookSubscriptionsWithContext(ctx, next, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data), fn(page)
+	})
+}
+
+// GetWebhookSubscriptionsAll collects up to limit of the webhook subscriptions from every page into one response, or
+// all of them when limit is 0 or less.
+func (c *Client) GetWebhookSubscriptionsAll(ctx context.Context, after string, count int,
+	limit int) (*WebhookSubscriptions, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *WebhookSubscriptions) error {
+		return collector.add(page)
+	}
+	err := c.GetWebhookSubscriptionsPages(ctx, after, count, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*WebhookSubscriptions)
+	return all, nil
+}
+
+// GetChannelStreamSchedulePages calls fn with each page of the broadcaster's schedule segments, starting at after.
+func (c *Client) GetChannelStreamSchedulePages(ctx context.Context, broadcasterID string, IDs []string,
+	startTime *time.Time, utcOffset, count int, after string, fn func(page *ChannelStreamSchedule) error) error {
+	return walkPages(ctx, after, func(ctx context.Context, next string) (*TwitchPagination, int, error) {
+		page, err := c.GetChannelStreamScheduleWithContext(ctx, broadcasterID, IDs, startTime, utcOffset, count, next)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Pagination, len(page.Data.Segments), fn(page)
+	})
+}
+
+// GetChannelStreamScheduleAll collects up to limit of the broadcaster's schedule segments from every page into one
+// response, or all of them when limit is 0 or less.
+func (c *Client) GetChannelStreamScheduleAll(ctx context.Context, broadcasterID string, IDs []string,
+	startTime *time.Time, utcOffset, count int, after string, limit int) (*ChannelStreamSchedule, error) {
+	collector := &pageCollector{limit: limit}
+	add := func(page *ChannelStreamSchedule) error {
+		return collector.add(page)
+	}
+	err := c.GetChannelStreamSchedulePages(ctx, broadcasterID, IDs, startTime, utcOffset, count, after, add)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := collector.all.(*ChannelStreamSchedule)
+	return all, nil
+}
+
+// The responses returned by the pagers implement pagedResponse so the ...All variants can use pageCollector.
+
+func (r *BannedEvents) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *BannedEvents) appendPage(page pagedResponse) {
+	next := page.(*BannedEvents)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *BannedEvents) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *ChannelSearchResults) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *ChannelSearchResults) appendPage(page pagedResponse) {
+	next := page.(*ChannelSearchResults)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *ChannelSearchResults) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *ChannelStreamSchedule) pageLen() int {
+	return len(r.Data.Segments)
+}
+
+func (r *ChannelStreamSchedule) appendPage(page pagedResponse) {
+	next := page.(*ChannelStreamSchedule)
+	r.Data.Segments = append(r.Data.Segments, next.Data.Segments...)
+	r.Pagination = next.Pagination
+}
+
+func (r *ChannelStreamSchedule) truncate(n int) {
+	r.Data.Segments = r.Data.Segments[:n]
+}
+
+func (r *Clips) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Clips) appendPage(page pagedResponse) {
+	next := page.(*Clips)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Clips) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *CustomRewardRedemptions) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *CustomRewardRedemptions) appendPage(page pagedResponse) {
+	next := page.(*CustomRewardRedemptions)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *CustomRewardRedemptions) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Games) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Games) appendPage(page pagedResponse) {
+	next := page.(*Games)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Games) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *HypeTrainEvents) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *HypeTrainEvents) appendPage(page pagedResponse) {
+	next := page.(*HypeTrainEvents)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *HypeTrainEvents) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *ModeratorEvents) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *ModeratorEvents) appendPage(page pagedResponse) {
+	next := page.(*ModeratorEvents)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *ModeratorEvents) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Moderators) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Moderators) appendPage(page pagedResponse) {
+	next := page.(*Moderators)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Moderators) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Polls) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Polls) appendPage(page pagedResponse) {
+	next := page.(*Polls)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Polls) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Predictions) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Predictions) appendPage(page pagedResponse) {
+	next := page.(*Predictions)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Predictions) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *StreamMarkers) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *StreamMarkers) appendPage(page pagedResponse) {
+	next := page.(*StreamMarkers)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *StreamMarkers) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *StreamTags) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *StreamTags) appendPage(page pagedResponse) {
+	next := page.(*StreamTags)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *StreamTags) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Streams) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Streams) appendPage(page pagedResponse) {
+	next := page.(*Streams)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Streams) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Subscriptions) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Subscriptions) appendPage(page pagedResponse) {
+	next := page.(*Subscriptions)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Subscriptions) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *UserBlockList) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *UserBlockList) appendPage(page pagedResponse) {
+	next := page.(*UserBlockList)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *UserBlockList) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *UserFollows) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *UserFollows) appendPage(page pagedResponse) {
+	next := page.(*UserFollows)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *UserFollows) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *Video) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *Video) appendPage(page pagedResponse) {
+	next := page.(*Video)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *Video) truncate(n int) {
+	r.Data = r.Data[:n]
+}
+
+func (r *WebhookSubscriptions) pageLen() int {
+	return len(r.Data)
+}
+
+func (r *WebhookSubscriptions) appendPage(page pagedResponse) {
+	next := page.(*WebhookSubscriptions)
+	r.Data = append(r.Data, next.Data...)
+	r.Pagination = next.Pagination
+}
+
+func (r *WebhookSubscriptions) truncate(n int) {
+	r.Data = r.Data[:n]
+}
@@ -0,0 +1,78 @@
+package helix
+
+import (
+	"context"
+	"errors"
+)
+
+// pageFetcher requests the page at cursor, and returns its pagination and the number of items it held.
+type pageFetcher func(ctx context.Context, cursor string) (*TwitchPagination, int, error)
+
+// walkPages calls fetch starting at cursor, following the returned cursors until twitch reports no more pages, a page
+// comes back empty, or fetch returns an error. ErrStopPaging ends the walk without an error.
+func walkPages(ctx context.Context, cursor string, fetch pageFetcher) error {
+	seen := make(map[string]bool)
+	if cursor != "" {
+		seen[cursor] = true
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pagination, items, err := fetch(ctx, cursor)
+		if errors.Is(err, ErrStopPaging) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if items == 0 || pagination == nil || pagination.Cursor == "" {
+			return nil
+		}
+		if seen[pagination.Cursor] {
+			return ErrRepeatedCursor
+		}
+		seen[pagination.Cursor] = true
+		cursor = pagination.Cursor
+	}
+}
+
+// pageCursors returns the after and before arguments for the next request. Paging runs backwards when the caller
+// started with a before cursor, and forwards otherwise.
+func pageCursors(backwards bool, cursor string) (after, before string) {
+	if backwards {
+		return "", cursor
+	}
+	return cursor, ""
+}
+
+// pagedResponse is a paginated response, letting pageCollector combine its pages into one.
+type pagedResponse interface {
+	// pageLen returns the number of items held.
+	pageLen() int
+	// appendPage appends the items of page, which is the same type, and takes its pagination.
+	appendPage(page pagedResponse)
+	// truncate keeps only the first n items.
+	truncate(n int)
+}
+
+// pageCollector combines the pages it is given into the first one, so its other fields such as Total come from the
+// first page while Pagination is left as returned with the last page.  Once limit items have been collected the rest
+// are dropped and ErrStopPaging is returned, a limit of 0 or less collects everything.
+type pageCollector struct {
+	limit int
+	all   pagedResponse
+}
+
+func (p *pageCollector) add(page pagedResponse) error {
+	if p.all == nil {
+		p.all = page
+	} else {
+		p.all.appendPage(page)
+	}
+	if p.limit > 0 && p.all.pageLen() >= p.limit {
+		p.all.truncate(p.limit)
+		return ErrStopPaging
+	}
+	return nil
+}
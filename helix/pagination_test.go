@@ -0,0 +1,171 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newPagesClient returns a client talking to a server that serves pages keyed by the cursor param, "" being the
+// first page.
+func newPagesClient(t *testing.T, cursorParam string, pages map[string]string, requested *[]string) (*Client, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get(cursorParam)
+		*requested = append(*requested, cursor)
+		page, ok := pages[cursor]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, page)
+		require.NoError(t, err)
+	}))
+
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client, err := NewClient(host, portNum, "foo", false)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	return client, ts.Close
+}
+
+var followPages = map[string]string{
+	"":   `{"total":5,"data":[{"from_id":"1"},{"from_id":"2"}],"pagination":{"cursor":"p2"}}`,
+	"p2": `{"total":5,"data":[{"from_id":"3"},{"from_id":"4"}],"pagination":{"cursor":"p3"}}`,
+	"p3": `{"total":5,"data":[{"from_id":"5"}],"pagination":{}}`,
+}
+
+func TestClient_GetUsersFollowsPages(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "after", followPages, &requested)
+	defer done()
+
+	var ids []string
+	err := client.GetUsersFollowsPages(context.Background(), "1234", "", "", 2, func(page *UserFollows) error {
+		for _, follow := range page.Data {
+			ids = append(ids, follow.FromId)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	require.Equal(t, []string{"", "p2", "p3"}, requested)
+}
+
+func TestClient_GetUsersFollowsAll(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "after", followPages, &requested)
+	defer done()
+
+	follows, err := client.GetUsersFollowsAll(context.Background(), "1234", "", "", 2, 0)
+	require.NoError(t, err)
+	require.Len(t, follows.Data, 5)
+	require.Equal(t, 5, follows.Total)
+	require.Equal(t, "5", follows.Data[4].FromId)
+
+	requested = nil
+	follows, err = client.GetUsersFollowsAll(context.Background(), "1234", "", "", 2, 3)
+	require.NoError(t, err)
+	require.Len(t, follows.Data, 3)
+	require.Equal(t, "3", follows.Data[2].FromId)
+	require.Equal(t, "p3", follows.Pagination.Cursor)
+	require.Equal(t, []string{"", "p2"}, requested)
+}
+
+func TestClient_GetUsersFollowsPagesStops(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "after", followPages, &requested)
+	defer done()
+
+	pages := 0
+	err := client.GetUsersFollowsPages(context.Background(), "1234", "", "", 2, func(page *UserFollows) error {
+		pages++
+		return ErrStopPaging
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, pages)
+
+	failed := errors.New("failed")
+	err = client.GetUsersFollowsPages(context.Background(), "1234", "", "", 2, func(page *UserFollows) error {
+		return failed
+	})
+	require.ErrorIs(t, err, failed)
+}
+
+func TestClient_GetUsersFollowsPagesRepeatedCursor(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "after", map[string]string{
+		"":   `{"data":[{"from_id":"1"}],"pagination":{"cursor":"p2"}}`,
+		"p2": `{"data":[{"from_id":"2"}],"pagination":{"cursor":"p2"}}`,
+	}, &requested)
+	defer done()
+
+	follows, err := client.GetUsersFollowsAll(context.Background(), "1234", "", "", 0, 0)
+	require.ErrorIs(t, err, ErrRepeatedCursor)
+	require.Nil(t, follows)
+	require.Equal(t, []string{"", "p2"}, requested)
+}
+
+func TestClient_GetUsersFollowsPagesError(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "after", map[string]string{
+		"": `{"data":[{"from_id":"1"}],"pagination":{"cursor":"missing"}}`,
+	}, &requested)
+	defer done()
+
+	follows, err := client.GetUsersFollowsAll(context.Background(), "1234", "", "", 0, 0)
+	require.Error(t, err)
+	require.Nil(t, follows)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	requested = nil
+	follows, err = client.GetUsersFollowsAll(ctx, "1234", "", "", 0, 0)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, follows)
+	require.Empty(t, requested)
+}
+
+func TestClient_GetClipsByBroadcasterPagesBackwards(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "before", map[string]string{
+		"c3": `{"data":[{"id":"c"}],"pagination":{"cursor":"c2"}}`,
+		"c2": `{"data":[{"id":"b"}],"pagination":{"cursor":"c1"}}`,
+		"c1": `{"data":[{"id":"a"}],"pagination":{}}`,
+	}, &requested)
+	defer done()
+
+	clips, err := client.GetClipsByBroadcasterAll(context.Background(), "1234", "", "c3", nil, nil, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, clips.Data, 3)
+	require.Equal(t, "a", clips.Data[2].ID)
+	require.Equal(t, []string{"c3", "c2", "c1"}, requested)
+}
+
+func TestClient_GetChannelStreamScheduleAll(t *testing.T) {
+	var requested []string
+	client, done := newPagesClient(t, "after", map[string]string{
+		"":   `{"data":{"segments":[{"id":"s1"}],"broadcaster_id":"1234"},"pagination":{"cursor":"p2"}}`,
+		"p2": `{"data":{"segments":[{"id":"s2"}],"broadcaster_id":"1234"},"pagination":{}}`,
+	}, &requested)
+	defer done()
+
+	schedule, err := client.GetChannelStreamScheduleAll(context.Background(), "1234", nil, nil, 0, 0, "", 0)
+	require.NoError(t, err)
+	require.Len(t, schedule.Data.Segments, 2)
+	require.Equal(t, "s2", schedule.Data.Segments[1].ID)
+	require.Equal(t, "1234", schedule.Data.BroadcasterId)
+}
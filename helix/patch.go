@@ -1,6 +1,7 @@
 package helix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	gotau "github.com/Team-TAU/tau-client-go"
@@ -11,7 +12,12 @@ import (
 // PatchRequest handles generic PATCH requests to twitch's API, leveraged internally as well as allows you
 //to make raw requests in case an update to the API comes out and the library hasn't been updated yet.
 func (c *Client) PatchRequest(endpoint string, params map[string][]string, body []byte) (bool, []byte, error) {
-	response, err := c.helixRequest(endpoint, params, body, "PATCH")
+	return c.PatchRequestWithContext(context.Background(), endpoint, params, body)
+}
+
+// PatchRequestWithContext is the same as PatchRequest but takes a context to allow for cancellation and deadlines.
+func (c *Client) PatchRequestWithContext(ctx context.Context, endpoint string, params map[string][]string, body []byte) (bool, []byte, error) {
+	response, err := c.helixRequest(ctx, endpoint, params, body, "PATCH")
 	if err != nil {
 		return false, nil, err
 	}
@@ -20,6 +26,11 @@ func (c *Client) PatchRequest(endpoint string, params map[string][]string, body
 
 // ModifyChannelInformation updates the channel information based on https://dev.twitch.tv/docs/api/reference#modify-channel-information.
 func (c *Client) ModifyChannelInformation(broadcasterID string, gameID, language, title *string, delay *int) (bool, error) {
+	return c.ModifyChannelInformationWithContext(context.Background(), broadcasterID, gameID, language, title, delay)
+}
+
+// ModifyChannelInformationWithContext is the same as ModifyChannelInformation but takes a context to allow for cancellation and deadlines.
+func (c *Client) ModifyChannelInformationWithContext(ctx context.Context, broadcasterID string, gameID, language, title *string, delay *int) (bool, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return false, gotau.BadRequestError{
@@ -55,13 +66,18 @@ func (c *Client) ModifyChannelInformation(broadcasterID string, gameID, language
 		return false, err
 	}
 
-	changed, _, err := c.PatchRequest("channels", params, body)
+	changed, _, err := c.PatchRequestWithContext(ctx, "channels", params, body)
 	return changed, err
 }
 
 // UpdateCustomReward updates a custom reward that is owned by your client id.
 //For more information see https://dev.twitch.tv/docs/api/reference#update-custom-reward.
 func (c *Client) UpdateCustomReward(broadcasterID string, ID string, change *CustomRewardsUpdate) (*CustomRewards, error) {
+	return c.UpdateCustomRewardWithContext(context.Background(), broadcasterID, ID, change)
+}
+
+// UpdateCustomRewardWithContext is the same as UpdateCustomReward but takes a context to allow for cancellation and deadlines.
+func (c *Client) UpdateCustomRewardWithContext(ctx context.Context, broadcasterID string, ID string, change *CustomRewardsUpdate) (*CustomRewards, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	ID = strings.TrimSpace(ID)
 	if broadcasterID == "" {
@@ -89,7 +105,7 @@ func (c *Client) UpdateCustomReward(broadcasterID string, ID string, change *Cus
 		return nil, err
 	}
 
-	_, response, err := c.PatchRequest("channel_points/custom_rewards", params, body)
+	_, response, err := c.PatchRequestWithContext(ctx, "channel_points/custom_rewards", params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -102,11 +118,18 @@ func (c *Client) UpdateCustomReward(broadcasterID string, ID string, change *Cus
 	return reward, nil
 }
 
-// UpdateRedemptionStatus updates the status of one or more redemptions for a reward owned by your client id.
+// UpdateRedemptionStatus updates the status of one or more redemptions for a reward owned by your client id, status is
+// converted to twitch's upper case so the EventSub spelling works too.
 // For more information see https://dev.twitch.tv/docs/api/reference#update-redemption-status.
-func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redemptionIDs []string, status string) (*CustomRewardRedemptions, error) {
+func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redemptionIDs []string, status gotau.RedemptionStatus) (*CustomRewardRedemptions, error) {
+	return c.UpdateRedemptionStatusWithContext(context.Background(), broadcasterID, rewardID, redemptionIDs, status)
+}
+
+// UpdateRedemptionStatusWithContext is the same as UpdateRedemptionStatus but takes a context to allow for cancellation and deadlines.
+func (c *Client) UpdateRedemptionStatusWithContext(ctx context.Context, broadcasterID, rewardID string, redemptionIDs []string, status gotau.RedemptionStatus) (*CustomRewardRedemptions, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	rewardID = strings.TrimSpace(rewardID)
+	status = gotau.RedemptionStatus(strings.ToUpper(string(status)))
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, broadcast can't be blank",
@@ -126,7 +149,7 @@ func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redempti
 			Err: fmt.Sprintf("invalid request,  maximum of 50 redemptionIDs, but you supplied %d", len(redemptionIDs)),
 		}
 	}
-	if status != "FULFILLED" && status != "CANCELED" {
+	if status != RedemptionFulfilled && status != RedemptionCanceled {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request,  status can only be one of FULFILLED or CANCELED",
 		}
@@ -139,7 +162,7 @@ func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redempti
 	}
 
 	bodyMap := map[string]string{
-		"status": status,
+		"status": string(status),
 	}
 
 	body, err := json.Marshal(bodyMap)
@@ -147,7 +170,7 @@ func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redempti
 		return nil, err
 	}
 
-	_, response, err := c.PatchRequest("channel_points/custom_rewards", params, body)
+	_, response, err := c.PatchRequestWithContext(ctx, "channel_points/custom_rewards", params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -161,11 +184,17 @@ func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redempti
 	return redemptions, nil
 }
 
-// EndPoll allows you to end/archive a poll, see https://dev.twitch.tv/docs/api/reference#end-poll
-func (c *Client) EndPoll(broadcasterID, pollID, status string) (*Polls, error) {
+// EndPoll allows you to end/archive a poll, status is converted to twitch's upper case so the EventSub spelling works
+// too, see https://dev.twitch.tv/docs/api/reference#end-poll
+func (c *Client) EndPoll(broadcasterID, pollID string, status gotau.PollStatus) (*Polls, error) {
+	return c.EndPollWithContext(context.Background(), broadcasterID, pollID, status)
+}
+
+// EndPollWithContext is the same as EndPoll but takes a context to allow for cancellation and deadlines.
+func (c *Client) EndPollWithContext(ctx context.Context, broadcasterID, pollID string, status gotau.PollStatus) (*Polls, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	pollID = strings.TrimSpace(pollID)
-	status = strings.TrimSpace(status)
+	status = gotau.PollStatus(strings.ToUpper(strings.TrimSpace(string(status))))
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, broadcast can't be blank",
@@ -176,7 +205,7 @@ func (c *Client) EndPoll(broadcasterID, pollID, status string) (*Polls, error) {
 			Err: "invalid request, poll id can't be blank",
 		}
 	}
-	if status != "TERMINATED" && status != "ARCHIVED" {
+	if status != PollTerminated && status != PollArchived {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, status must either be TERMINATED or ARCHIVED",
 		}
@@ -184,14 +213,14 @@ func (c *Client) EndPoll(broadcasterID, pollID, status string) (*Polls, error) {
 	bodyMap := map[string]string{
 		"broadcaster_id": broadcasterID,
 		"id":             pollID,
-		"status":         status,
+		"status":         string(status),
 	}
 	body, err := json.Marshal(bodyMap)
 	if err != nil {
 		return nil, err
 	}
 
-	_, responseBody, err := c.PatchRequest("polls", nil, body)
+	_, responseBody, err := c.PatchRequestWithContext(ctx, "polls", nil, body)
 	if err != nil {
 		return nil, err
 	}
@@ -204,11 +233,17 @@ func (c *Client) EndPoll(broadcasterID, pollID, status string) (*Polls, error) {
 	return polls, nil
 }
 
-// EndPrediction allows you to lock/payout/cancel a prediction, see https://dev.twitch.tv/docs/api/reference#end-prediction
-func (c *Client) EndPrediction(broadcasterID, predictionID, status string, winningOutcome *string) (*Predictions, error) {
+// EndPrediction allows you to lock/payout/cancel a prediction, status is converted to twitch's upper case so the
+// EventSub spelling works too, see https://dev.twitch.tv/docs/api/reference#end-prediction
+func (c *Client) EndPrediction(broadcasterID, predictionID string, status gotau.PredictionStatus, winningOutcome *string) (*Predictions, error) {
+	return c.EndPredictionWithContext(context.Background(), broadcasterID, predictionID, status, winningOutcome)
+}
+
+// EndPredictionWithContext is the same as EndPrediction but takes a context to allow for cancellation and deadlines.
+func (c *Client) EndPredictionWithContext(ctx context.Context, broadcasterID, predictionID string, status gotau.PredictionStatus, winningOutcome *string) (*Predictions, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	predictionID = strings.TrimSpace(predictionID)
-	status = strings.TrimSpace(status)
+	status = gotau.PredictionStatus(strings.ToUpper(strings.TrimSpace(string(status))))
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, broadcast can't be blank",
@@ -219,12 +254,12 @@ func (c *Client) EndPrediction(broadcasterID, predictionID, status string, winni
 			Err: "invalid request, prediction id can't be blank",
 		}
 	}
-	if status != "RESOLVED" && status != "CANCELED" && status != "LOCKED" {
+	if status != PredictionResolved && status != PredictionCanceled && status != PredictionLocked {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, status must either be RESOLVED, CANCELED, or LOCKED",
 		}
 	}
-	if status == "RESOLVED" && winningOutcome == nil {
+	if status == PredictionResolved && winningOutcome == nil {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, if status RESOLVED, winning outcome must be set",
 		}
@@ -232,7 +267,7 @@ func (c *Client) EndPrediction(broadcasterID, predictionID, status string, winni
 	bodyMap := map[string]string{
 		"broadcaster_id": broadcasterID,
 		"id":             predictionID,
-		"status":         status,
+		"status":         string(status),
 	}
 	if winningOutcome != nil {
 		bodyMap["winning_outcome_id"] = *winningOutcome
@@ -243,7 +278,7 @@ func (c *Client) EndPrediction(broadcasterID, predictionID, status string, winni
 		return nil, err
 	}
 
-	_, responseBody, err := c.PatchRequest("predictions", nil, body)
+	_, responseBody, err := c.PatchRequestWithContext(ctx, "predictions", nil, body)
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +293,12 @@ func (c *Client) EndPrediction(broadcasterID, predictionID, status string, winni
 
 // UpdateChannelStreamSchedule updates the channel stream schedule per https://dev.twitch.tv/docs/api/reference#update-channel-stream-schedule.
 func (c *Client) UpdateChannelStreamSchedule(broadcasterID string, vacationEnabled *bool,
+	vacationStartTime, vacationEndTime *time.Time, timezone *string) (bool, error) {
+	return c.UpdateChannelStreamScheduleWithContext(context.Background(), broadcasterID, vacationEnabled, vacationStartTime, vacationEndTime, timezone)
+}
+
+// UpdateChannelStreamScheduleWithContext is the same as UpdateChannelStreamSchedule but takes a context to allow for cancellation and deadlines.
+func (c *Client) UpdateChannelStreamScheduleWithContext(ctx context.Context, broadcasterID string, vacationEnabled *bool,
 	vacationStartTime, vacationEndTime *time.Time, timezone *string) (bool, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
@@ -293,7 +334,7 @@ func (c *Client) UpdateChannelStreamSchedule(broadcasterID string, vacationEnabl
 		params["is_vacation_enabled"] = []string{"false"}
 	}
 
-	changed, _, err := c.PatchRequest("schedule/settings", params, nil)
+	changed, _, err := c.PatchRequestWithContext(ctx, "schedule/settings", params, nil)
 	if err != nil {
 		return false, err
 	}
@@ -304,6 +345,11 @@ func (c *Client) UpdateChannelStreamSchedule(broadcasterID string, vacationEnabl
 // UpdateChannelStreamScheduleSegment updates a segment of your schedule per
 // https://dev.twitch.tv/docs/api/reference#update-channel-stream-schedule-segment returning the resulting schedule segment.
 func (c *Client) UpdateChannelStreamScheduleSegment(broadcasterID, segmentID string, update *StreamScheduleSegmentUpdate) (*ChannelStreamSchedule, error) {
+	return c.UpdateChannelStreamScheduleSegmentWithContext(context.Background(), broadcasterID, segmentID, update)
+}
+
+// UpdateChannelStreamScheduleSegmentWithContext is the same as UpdateChannelStreamScheduleSegment but takes a context to allow for cancellation and deadlines.
+func (c *Client) UpdateChannelStreamScheduleSegmentWithContext(ctx context.Context, broadcasterID, segmentID string, update *StreamScheduleSegmentUpdate) (*ChannelStreamSchedule, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	segmentID = strings.TrimSpace(segmentID)
 	if broadcasterID == "" {
@@ -330,7 +376,7 @@ func (c *Client) UpdateChannelStreamScheduleSegment(broadcasterID, segmentID str
 	if err != nil {
 		return nil, err
 	}
-	_, responseBody, err := c.PatchRequest("schedule/segment", params, body)
+	_, responseBody, err := c.PatchRequestWithContext(ctx, "schedule/segment", params, body)
 	if err != nil {
 		return nil, err
 	}
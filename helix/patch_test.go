@@ -96,7 +96,10 @@ func TestClient_PatchRequestReturnsGenericError(t *testing.T) {
 
 	shouldBeFalse, shouldBeNil, err := client.PatchRequest("channels", nil, nil)
 	require.Error(t, err)
-	require.IsType(t, gotau.GenericError{}, err)
+	require.IsType(t, APIError{}, err)
+	genericErr := gotau.GenericError{}
+	require.ErrorAs(t, err, &genericErr)
+	require.Equal(t, http.StatusBadRequest, genericErr.Code)
 	require.False(t, shouldBeFalse)
 	require.Nil(t, shouldBeNil)
 }
@@ -305,12 +308,19 @@ func TestClient_UpdateRedemptionStatusReturns200(t *testing.T) {
 	require.Equal(t, "torpedo09", redemption.Data[0].UserLogin)
 	require.Equal(t, "torpedo09", redemption.Data[0].UserName)
 	require.Zero(t, redemption.Data[0].UserInput)
-	require.Equal(t, "CANCELED", redemption.Data[0].Status)
+	require.Equal(t, RedemptionCanceled, redemption.Data[0].Status)
 	require.Equal(t, 2020, redemption.Data[0].RedeemedAt.Year())
 	require.Equal(t, "92af127c-7326-4483-a52b-b0da0be61c01", redemption.Data[0].Reward.ID)
 	require.Equal(t, "game analysis", redemption.Data[0].Reward.Title)
 	require.Zero(t, redemption.Data[0].Reward.Prompt)
 	require.Equal(t, 50000, redemption.Data[0].Reward.Cost)
+
+	// a decoded status can be sent straight back, and the EventSub spelling is converted to twitch's
+	for _, status := range []gotau.RedemptionStatus{redemption.Data[0].Status, gotau.RedemptionCanceled} {
+		_, err = client.UpdateRedemptionStatus("274637212", "92af127c-7326-4483-a52b-b0da0be61c01",
+			[]string{"17fa2df1-ad76-4804-bfa5-a40ef63efe63"}, status)
+		require.NoError(t, err)
+	}
 }
 
 func TestClient_UpdateRedemptionStatusReturnsError(t *testing.T) {
@@ -582,7 +592,7 @@ func TestClient_EndPollReturns200(t *testing.T) {
 	require.Equal(t, 0, poll.Data[0].BitsPerVote)
 	require.True(t, poll.Data[0].ChannelPointsVotingEnabled)
 	require.Equal(t, 100, poll.Data[0].ChannelPointsPerVote)
-	require.Equal(t, "TERMINATED", poll.Data[0].Status)
+	require.Equal(t, PollTerminated, poll.Data[0].Status)
 	require.Equal(t, 1800, poll.Data[0].Duration)
 	require.Equal(t, 2021, poll.Data[0].StartedAt.Year())
 	require.Equal(t, 2021, poll.Data[0].EndedAt.Year())
@@ -675,7 +685,7 @@ func TestClient_EndPredictionReturns200(t *testing.T) {
 	require.Equal(t, winner, prediction.Data[0].WinningOutcomeId)
 	require.Len(t, prediction.Data[0].Outcomes, 2)
 	require.Equal(t, 120, prediction.Data[0].PredictionWindow)
-	require.Equal(t, "RESOLVED", prediction.Data[0].Status)
+	require.Equal(t, PredictionResolved, prediction.Data[0].Status)
 	require.Equal(t, 2021, prediction.Data[0].CreatedAt.Year())
 	require.Equal(t, 2021, prediction.Data[0].EndedAt.Year())
 	require.Equal(t, 2021, prediction.Data[0].LockedAt.Year())
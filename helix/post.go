@@ -1,6 +1,7 @@
 package helix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	gotau "github.com/Team-TAU/tau-client-go"
@@ -10,11 +11,21 @@ import (
 // PostRequest handles generic POST requests to twitch's API, leveraged internally as well as allows you
 //to make raw requests in case an update to the API comes out and the library hasn't been updated yet.
 func (c *Client) PostRequest(endpoint string, params map[string][]string, body []byte) ([]byte, error) {
-	return c.helixRequest(endpoint, params, body, "POST")
+	return c.PostRequestWithContext(context.Background(), endpoint, params, body)
+}
+
+// PostRequestWithContext is the same as PostRequest but takes a context to allow for cancellation and deadlines.
+func (c *Client) PostRequestWithContext(ctx context.Context, endpoint string, params map[string][]string, body []byte) ([]byte, error) {
+	return c.helixRequest(ctx, endpoint, params, body, "POST")
 }
 
 // CreateCustomReward is used to create custom channel point rewards, see https://dev.twitch.tv/docs/api/reference#create-custom-rewards.
 func (c *Client) CreateCustomReward(broadcasterID string, customReward *CustomRewardsUpdate) (*CustomRewards, error) {
+	return c.CreateCustomRewardWithContext(context.Background(), broadcasterID, customReward)
+}
+
+// CreateCustomRewardWithContext is the same as CreateCustomReward but takes a context to allow for cancellation and deadlines.
+func (c *Client) CreateCustomRewardWithContext(ctx context.Context, broadcasterID string, customReward *CustomRewardsUpdate) (*CustomRewards, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -35,7 +46,7 @@ func (c *Client) CreateCustomReward(broadcasterID string, customReward *CustomRe
 		return nil, err
 	}
 
-	responseBody, err := c.PostRequest("channel_points/custom_rewards", params, body)
+	responseBody, err := c.PostRequestWithContext(ctx, "channel_points/custom_rewards", params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +61,11 @@ func (c *Client) CreateCustomReward(broadcasterID string, customReward *CustomRe
 
 // CreateClip allows you to create clips, see https://dev.twitch.tv/docs/api/reference#create-clip
 func (c *Client) CreateClip(broadcasterID string, hasDelay bool) (string, string, error) {
+	return c.CreateClipWithContext(context.Background(), broadcasterID, hasDelay)
+}
+
+// CreateClipWithContext is the same as CreateClip but takes a context to allow for cancellation and deadlines.
+func (c *Client) CreateClipWithContext(ctx context.Context, broadcasterID string, hasDelay bool) (string, string, error) {
 	type temp struct {
 		Data []struct {
 			ID      string `json:"id"`
@@ -69,7 +85,7 @@ func (c *Client) CreateClip(broadcasterID string, hasDelay bool) (string, string
 		params["has_delay"] = []string{fmt.Sprintf("%t", hasDelay)}
 	}
 
-	response, err := c.PostRequest("clips", params, nil)
+	response, err := c.PostRequestWithContext(ctx, "clips", params, nil)
 	if err != nil {
 		return "", "", err
 	}
@@ -89,6 +105,11 @@ func (c *Client) CreateClip(broadcasterID string, hasDelay bool) (string, string
 
 // CreatePoll can be used to create a poll on your channel, see https://dev.twitch.tv/docs/api/reference#create-poll
 func (c *Client) CreatePoll(poll *CreatePoll) (*Polls, error) {
+	return c.CreatePollWithContext(context.Background(), poll)
+}
+
+// CreatePollWithContext is the same as CreatePoll but takes a context to allow for cancellation and deadlines.
+func (c *Client) CreatePollWithContext(ctx context.Context, poll *CreatePoll) (*Polls, error) {
 	if poll == nil {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, poll can't be nil",
@@ -100,7 +121,7 @@ func (c *Client) CreatePoll(poll *CreatePoll) (*Polls, error) {
 		return nil, err
 	}
 
-	responseBody, err := c.PostRequest("polls", nil, body)
+	responseBody, err := c.PostRequestWithContext(ctx, "polls", nil, body)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +138,11 @@ func (c *Client) CreatePoll(poll *CreatePoll) (*Polls, error) {
 // CreatePrediction allows you to create predictions for your viewers to bet on with channel points.
 // See https://dev.twitch.tv/docs/api/reference#create-prediction
 func (c *Client) CreatePrediction(prediction *CreatePrediction) (*Predictions, error) {
+	return c.CreatePredictionWithContext(context.Background(), prediction)
+}
+
+// CreatePredictionWithContext is the same as CreatePrediction but takes a context to allow for cancellation and deadlines.
+func (c *Client) CreatePredictionWithContext(ctx context.Context, prediction *CreatePrediction) (*Predictions, error) {
 	if prediction == nil {
 		return nil, gotau.BadRequestError{
 			Err: "invalid request, prediction can't be nil",
@@ -128,7 +154,7 @@ func (c *Client) CreatePrediction(prediction *CreatePrediction) (*Predictions, e
 		return nil, err
 	}
 
-	responseBody, err := c.PostRequest("predictions", nil, body)
+	responseBody, err := c.PostRequestWithContext(ctx, "predictions", nil, body)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +171,11 @@ func (c *Client) CreatePrediction(prediction *CreatePrediction) (*Predictions, e
 // CreateChannelStreamScheduleSegment can be used to create a new scheduled stream,
 // see https://dev.twitch.tv/docs/api/reference#create-channel-stream-schedule-segment
 func (c *Client) CreateChannelStreamScheduleSegment(broadcasterID string, segment *StreamScheduleSegmentUpdate) (*ChannelStreamSchedule, error) {
+	return c.CreateChannelStreamScheduleSegmentWithContext(context.Background(), broadcasterID, segment)
+}
+
+// CreateChannelStreamScheduleSegmentWithContext is the same as CreateChannelStreamScheduleSegment but takes a context to allow for cancellation and deadlines.
+func (c *Client) CreateChannelStreamScheduleSegmentWithContext(ctx context.Context, broadcasterID string, segment *StreamScheduleSegmentUpdate) (*ChannelStreamSchedule, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return nil, gotau.BadRequestError{
@@ -166,7 +197,7 @@ func (c *Client) CreateChannelStreamScheduleSegment(broadcasterID string, segmen
 		return nil, err
 	}
 
-	responseBody, err := c.PostRequest("schedule/segment", params, body)
+	responseBody, err := c.PostRequestWithContext(ctx, "schedule/segment", params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +213,11 @@ func (c *Client) CreateChannelStreamScheduleSegment(broadcasterID string, segmen
 
 // CreateUserFollows allows you to follow a user, see https://dev.twitch.tv/docs/api/reference#create-user-follows
 func (c *Client) CreateUserFollows(fromID, toID string, allowNotifications bool) (bool, error) {
+	return c.CreateUserFollowsWithContext(context.Background(), fromID, toID, allowNotifications)
+}
+
+// CreateUserFollowsWithContext is the same as CreateUserFollows but takes a context to allow for cancellation and deadlines.
+func (c *Client) CreateUserFollowsWithContext(ctx context.Context, fromID, toID string, allowNotifications bool) (bool, error) {
 	fromID = strings.TrimSpace(fromID)
 	toID = strings.TrimSpace(toID)
 
@@ -209,7 +245,7 @@ func (c *Client) CreateUserFollows(fromID, toID string, allowNotifications bool)
 		return false, err
 	}
 
-	_, err = c.PostRequest("users/follows", nil, body)
+	_, err = c.PostRequestWithContext(ctx, "users/follows", nil, body)
 	if err != nil {
 		return false, err
 	}
@@ -219,6 +255,11 @@ func (c *Client) CreateUserFollows(fromID, toID string, allowNotifications bool)
 
 // StartCommercial allows you to start a commercial on a stream, see https://dev.twitch.tv/docs/api/reference#start-commercial
 func (c *Client) StartCommercial(broadcasterID string, length int) (*Commercial, error) {
+	return c.StartCommercialWithContext(context.Background(), broadcasterID, length)
+}
+
+// StartCommercialWithContext is the same as StartCommercial but takes a context to allow for cancellation and deadlines.
+func (c *Client) StartCommercialWithContext(ctx context.Context, broadcasterID string, length int) (*Commercial, error) {
 	type commercial struct {
 		BroadcasterID string `json:"broadcaster_id"`
 		Length        int    `json:"length"`
@@ -258,7 +299,7 @@ func (c *Client) StartCommercial(broadcasterID string, length int) (*Commercial,
 		return nil, err
 	}
 
-	responseBody, err := c.PostRequest("channels/commercial", nil, body)
+	responseBody, err := c.PostRequestWithContext(ctx, "channels/commercial", nil, body)
 	if err != nil {
 		return nil, err
 	}
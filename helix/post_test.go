@@ -62,7 +62,10 @@ func TestClient_PostRequestReturnsGenericError(t *testing.T) {
 
 	shouldBeNil, err := client.PostRequest("channels", nil, nil)
 	require.Error(t, err)
-	require.IsType(t, gotau.GenericError{}, err)
+	require.IsType(t, APIError{}, err)
+	genericErr := gotau.GenericError{}
+	require.ErrorAs(t, err, &genericErr)
+	require.Equal(t, http.StatusBadRequest, genericErr.Code)
 	require.Nil(t, shouldBeNil)
 }
 
@@ -308,7 +311,7 @@ func TestClient_CreatePollReturns200(t *testing.T) {
 	require.Zero(t, poll.Data[0].BitsPerVote)
 	require.True(t, poll.Data[0].ChannelPointsVotingEnabled)
 	require.Equal(t, 100, poll.Data[0].ChannelPointsPerVote)
-	require.Equal(t, "ACTIVE", poll.Data[0].Status)
+	require.Equal(t, PollActive, poll.Data[0].Status)
 	require.Equal(t, 1800, poll.Data[0].Duration)
 	require.Equal(t, 2021, poll.Data[0].StartedAt.Year())
 }
@@ -383,7 +386,7 @@ func TestClient_CreatePredictionReturns200(t *testing.T) {
 	require.Zero(t, prediction.Data[0].WinningOutcomeId)
 	require.Len(t, prediction.Data[0].Outcomes, 2)
 	require.Equal(t, 120, prediction.Data[0].PredictionWindow)
-	require.Equal(t, "ACTIVE", prediction.Data[0].Status)
+	require.Equal(t, PredictionActive, prediction.Data[0].Status)
 	require.Equal(t, 2021, prediction.Data[0].CreatedAt.Year())
 	require.Nil(t, prediction.Data[0].EndedAt)
 	require.Nil(t, prediction.Data[0].LockedAt)
@@ -1,6 +1,7 @@
 package helix
 
 import (
+	"context"
 	"encoding/json"
 	gotau "github.com/Team-TAU/tau-client-go"
 	"strings"
@@ -8,11 +9,21 @@ import (
 
 // PutRequest wraps PUT requests to helix thru TAU
 func (c *Client) PutRequest(endpoint string, params map[string][]string, body []byte) ([]byte, error) {
-	return c.helixRequest(endpoint, params, body, "PUT")
+	return c.PutRequestWithContext(context.Background(), endpoint, params, body)
+}
+
+// PutRequestWithContext is the same as PutRequest but takes a context to allow for cancellation and deadlines.
+func (c *Client) PutRequestWithContext(ctx context.Context, endpoint string, params map[string][]string, body []byte) ([]byte, error) {
+	return c.helixRequest(ctx, endpoint, params, body, "PUT")
 }
 
 // ReplaceStreamTags can be used to set/reset a streams tags, see https://dev.twitch.tv/docs/api/reference#replace-stream-tags
 func (c *Client) ReplaceStreamTags(broadcasterID string, tags []string) (bool, error) {
+	return c.ReplaceStreamTagsWithContext(context.Background(), broadcasterID, tags)
+}
+
+// ReplaceStreamTagsWithContext is the same as ReplaceStreamTags but takes a context to allow for cancellation and deadlines.
+func (c *Client) ReplaceStreamTagsWithContext(ctx context.Context, broadcasterID string, tags []string) (bool, error) {
 	broadcasterID = strings.TrimSpace(broadcasterID)
 	if broadcasterID == "" {
 		return false, gotau.BadRequestError{
@@ -39,7 +50,7 @@ func (c *Client) ReplaceStreamTags(broadcasterID string, tags []string) (bool, e
 		return false, err
 	}
 
-	_, err = c.PutRequest("streams/tags", params, body)
+	_, err = c.PutRequestWithContext(ctx, "streams/tags", params, body)
 	if err != nil {
 		return false, err
 	}
@@ -50,12 +61,17 @@ func (c *Client) ReplaceStreamTags(broadcasterID string, tags []string) (bool, e
 // UpdateUser allows you to update the description of a user.  If the description is nil it just gets the user.
 // See https://dev.twitch.tv/docs/api/reference#update-user.
 func (c *Client) UpdateUser(description *string) (*Users, error) {
+	return c.UpdateUserWithContext(context.Background(), description)
+}
+
+// UpdateUserWithContext is the same as UpdateUser but takes a context to allow for cancellation and deadlines.
+func (c *Client) UpdateUserWithContext(ctx context.Context, description *string) (*Users, error) {
 	params := make(map[string][]string)
 	if description != nil {
 		params["description"] = []string{*description}
 	}
 
-	body, err := c.PutRequest("users", params, nil)
+	body, err := c.PutRequestWithContext(ctx, "users", params, nil)
 	if err != nil {
 		return nil, err
 	}
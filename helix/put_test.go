@@ -62,7 +62,10 @@ func TestClient_PutRequestReturnsGenericError(t *testing.T) {
 
 	shouldBeNil, err := client.PutRequest("channels", nil, nil)
 	require.Error(t, err)
-	require.IsType(t, gotau.GenericError{}, err)
+	require.IsType(t, APIError{}, err)
+	genericErr := gotau.GenericError{}
+	require.ErrorAs(t, err, &genericErr)
+	require.Equal(t, http.StatusBadRequest, genericErr.Code)
 	require.Nil(t, shouldBeNil)
 }
 
@@ -0,0 +1,180 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitFallback is how long to back off after a 429 that didn't say when the bucket resets.
+const rateLimitFallback = time.Second
+
+// RateLimitBudget is the state of twitch's points bucket as of the last response, see
+// https://dev.twitch.tv/docs/api/guide#rate-limits
+type RateLimitBudget struct {
+	// Limit is the size of the bucket.
+	Limit int
+	// Remaining is the number of points left, less any requests that have been sent since.
+	Remaining int
+	// Reset is when the bucket will be full again.
+	Reset time.Time
+}
+
+// RateLimitConfig configures the rate limit scheduler of the helix client.  The scheduler tracks twitch's points
+// bucket from the Ratelimit-* response headers, holding requests back until the reset time once only Reserve points
+// are left, and retrying requests that are rate limited anyway.
+type RateLimitConfig struct {
+	// Reserve is the number of points to leave in the bucket, e.g. for other programs sharing the same token.
+	Reserve int
+	// MaxRetries is how many times a request that gets a 429 is retried after the reset time, 0 disables retries.
+	MaxRetries int
+	// MaxWait is the longest a request will be held back, if the reset is further away a RateLimitError is returned
+	// instead.  0 means no limit.
+	MaxWait time.Duration
+	// OnUpdate is called with the budget whenever a response updates it.
+	OnUpdate func(budget RateLimitBudget)
+	// OnWait is called before a request is held back for wait.
+	OnWait func(wait time.Duration, budget RateLimitBudget)
+}
+
+// DefaultRateLimitConfig keeps 1 point in reserve, retries rate limited requests 3 times and holds requests back for
+// at most a minute, which is as long as twitch takes to refill the bucket.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Reserve:    1,
+		MaxRetries: 3,
+		MaxWait:    time.Minute,
+	}
+}
+
+// SetRateLimiter enables the rate limit scheduler with the supplied config, passing nil disables it, which is the
+// default, in which case a 429 is returned straight away as a RateLimitError.
+func (c *Client) SetRateLimiter(config *RateLimitConfig) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if config == nil {
+		c.limiter = nil
+		return
+	}
+	c.limiter = newRateLimiter(*config)
+}
+
+// CurrentRateLimit returns the budget tracked by the rate limit scheduler, the bool is false if the scheduler is
+// disabled or hasn't seen any rate limit headers yet.
+func (c *Client) CurrentRateLimit() (RateLimitBudget, bool) {
+	limiter := c.rateLimiter()
+	if limiter == nil {
+		return RateLimitBudget{}, false
+	}
+	return limiter.current()
+}
+
+func (c *Client) rateLimiter() *rateLimiter {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.limiter
+}
+
+type rateLimiter struct {
+	config RateLimitConfig
+	lock   sync.Mutex
+	budget RateLimitBudget
+	known  bool
+	// now and after are swapped out by tests.
+	now   func() time.Time
+	after func(d time.Duration) <-chan time.Time
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		config: config,
+		now:    time.Now,
+		after:  time.After,
+	}
+}
+
+func (r *rateLimiter) current() (RateLimitBudget, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.budget, r.known
+}
+
+// wait blocks until there are points to spend on a request, and takes one.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	for {
+		r.lock.Lock()
+		now := r.now()
+		if r.known && !now.Before(r.budget.Reset) && r.budget.Limit > 0 {
+			r.budget.Remaining = r.budget.Limit
+		}
+		if !r.known || r.budget.Remaining > r.config.Reserve || !now.Before(r.budget.Reset) {
+			r.budget.Remaining--
+			r.lock.Unlock()
+			return nil
+		}
+		budget := r.budget
+		r.lock.Unlock()
+
+		wait := budget.Reset.Sub(now)
+		if r.config.MaxWait > 0 && wait > r.config.MaxWait {
+			reset := budget.Reset
+			return RateLimitError{
+				err:   "rate limited: bucket resets after the maximum wait",
+				reset: &reset,
+			}
+		}
+		if r.config.OnWait != nil {
+			r.config.OnWait(wait, budget)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.after(wait):
+		}
+	}
+}
+
+// update records the budget from the Ratelimit-* headers of a response, if it has them.
+func (r *rateLimiter) update(header http.Header) {
+	limit, err := strconv.Atoi(header.Get("Ratelimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get("Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	r.lock.Lock()
+	r.budget = RateLimitBudget{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}
+	r.known = true
+	budget := r.budget
+	r.lock.Unlock()
+
+	if r.config.OnUpdate != nil {
+		r.config.OnUpdate(budget)
+	}
+}
+
+// exhausted marks the bucket as empty after a 429, until reset or shortly if twitch didn't say.
+func (r *rateLimiter) exhausted(reset *time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.budget.Remaining = 0
+	if reset != nil {
+		r.budget.Reset = *reset
+	} else {
+		r.budget.Reset = r.now().Add(rateLimitFallback)
+	}
+	r.known = true
+}
@@ -0,0 +1,231 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// rateLimitResponse is one canned response from newRateLimitServer, a zero status means 200.
+type rateLimitResponse struct {
+	status    int
+	remaining string
+	reset     string
+}
+
+func newRateLimitServer(t *testing.T, responses []rateLimitResponse) (*Client, *int, func()) {
+	lock := sync.Mutex{}
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		response := responses[requests%len(responses)]
+		requests++
+		lock.Unlock()
+
+		if response.remaining != "" {
+			w.Header().Set("Ratelimit-Limit", "800")
+			w.Header().Set("Ratelimit-Remaining", response.remaining)
+		}
+		if response.reset != "" {
+			w.Header().Set("Ratelimit-Reset", response.reset)
+		}
+		if response.status != 0 {
+			w.WriteHeader(response.status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, `{"data":[]}`)
+		require.NoError(t, err)
+	}))
+
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client, err := NewClient(host, portNum, "foo", false)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	return client, &requests, ts.Close
+}
+
+// fakeWaits stops the limiter from sleeping, recording how long it would have waited instead.
+func fakeWaits(client *Client) *[]time.Duration {
+	waits := make([]time.Duration, 0)
+	client.limiter.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		client.limiter.now = func() time.Time {
+			return time.Now().Add(d)
+		}
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+	return &waits
+}
+
+func TestClient_SetRateLimiterTracksBudget(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	client, _, done := newRateLimitServer(t, []rateLimitResponse{
+		{remaining: "799", reset: strconv.FormatInt(reset, 10)},
+	})
+	defer done()
+
+	_, ok := client.CurrentRateLimit()
+	require.False(t, ok)
+
+	updates := make([]RateLimitBudget, 0)
+	config := DefaultRateLimitConfig()
+	config.OnUpdate = func(budget RateLimitBudget) {
+		updates = append(updates, budget)
+	}
+	client.SetRateLimiter(config)
+
+	_, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+
+	budget, ok := client.CurrentRateLimit()
+	require.True(t, ok)
+	require.Equal(t, RateLimitBudget{Limit: 800, Remaining: 799, Reset: time.Unix(reset, 0)}, budget)
+	require.Equal(t, []RateLimitBudget{budget}, updates)
+
+	client.SetRateLimiter(nil)
+	_, ok = client.CurrentRateLimit()
+	require.False(t, ok)
+}
+
+func TestClient_SetRateLimiterWaitsWhenLow(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second).Unix()
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{remaining: "1", reset: strconv.FormatInt(reset, 10)},
+	})
+	defer done()
+
+	var waited []time.Duration
+	config := DefaultRateLimitConfig()
+	config.OnWait = func(wait time.Duration, budget RateLimitBudget) {
+		waited = append(waited, wait)
+		require.Equal(t, 1, budget.Remaining)
+	}
+	client.SetRateLimiter(config)
+	waits := fakeWaits(client)
+
+	_, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+	require.Empty(t, *waits)
+
+	_, err = client.GetRequest("users", nil)
+	require.NoError(t, err)
+	require.Len(t, *waits, 1)
+	require.InDelta(t, 30*time.Second, (*waits)[0], float64(2*time.Second))
+	require.Equal(t, *waits, waited)
+	require.Equal(t, 2, *requests)
+
+	budget, ok := client.CurrentRateLimit()
+	require.True(t, ok)
+	require.Equal(t, 1, budget.Remaining)
+}
+
+func TestClient_SetRateLimiterRetries429(t *testing.T) {
+	reset := strconv.FormatInt(time.Now().Add(10*time.Second).Unix(), 10)
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{status: http.StatusTooManyRequests, remaining: "0", reset: reset},
+		{remaining: "799", reset: reset},
+	})
+	defer done()
+
+	client.SetRateLimiter(DefaultRateLimitConfig())
+	waits := fakeWaits(client)
+
+	body, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":[]}`, string(body))
+	require.Equal(t, 2, *requests)
+	require.Len(t, *waits, 1)
+}
+
+func TestClient_SetRateLimiterRetriesWithoutHeaders(t *testing.T) {
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{status: http.StatusTooManyRequests},
+		{},
+	})
+	defer done()
+
+	client.SetRateLimiter(DefaultRateLimitConfig())
+	waits := fakeWaits(client)
+
+	_, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, *requests)
+	require.Len(t, *waits, 1)
+	require.InDelta(t, rateLimitFallback, (*waits)[0], float64(100*time.Millisecond))
+}
+
+func TestClient_SetRateLimiterGivesUp(t *testing.T) {
+	reset := strconv.FormatInt(time.Now().Add(10*time.Second).Unix(), 10)
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{status: http.StatusTooManyRequests, remaining: "0", reset: reset},
+	})
+	defer done()
+
+	config := DefaultRateLimitConfig()
+	config.MaxRetries = 2
+	client.SetRateLimiter(config)
+	fakeWaits(client)
+
+	_, err := client.GetRequest("users", nil)
+	require.IsType(t, RateLimitError{}, err)
+	require.Equal(t, 3, *requests)
+
+	client.SetRateLimiter(nil)
+	_, err = client.GetRequest("users", nil)
+	require.IsType(t, RateLimitError{}, err)
+	require.Equal(t, 4, *requests)
+}
+
+func TestClient_SetRateLimiterMaxWait(t *testing.T) {
+	reset := time.Now().Add(10 * time.Minute).Unix()
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{remaining: "0", reset: strconv.FormatInt(reset, 10)},
+	})
+	defer done()
+
+	client.SetRateLimiter(DefaultRateLimitConfig())
+
+	_, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+
+	_, err = client.GetRequest("users", nil)
+	require.IsType(t, RateLimitError{}, err)
+	require.Equal(t, time.Unix(reset, 0), *err.(RateLimitError).ResetTime())
+	require.Equal(t, 1, *requests)
+}
+
+func TestClient_SetRateLimiterContextCanceled(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second).Unix()
+	client, requests, done := newRateLimitServer(t, []rateLimitResponse{
+		{remaining: "0", reset: strconv.FormatInt(reset, 10)},
+	})
+	defer done()
+
+	client.SetRateLimiter(DefaultRateLimitConfig())
+
+	_, err := client.GetRequest("users", nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.GetRequestWithContext(ctx, "users", nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, *requests)
+}
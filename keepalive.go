@@ -0,0 +1,107 @@
+package gotau
+
+import (
+	"net"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// KeepaliveConfig configures how the client detects a dead connection to TAU.  Pings are sent every PingInterval
+// and if nothing (a pong or any other message) is received within PingInterval + PongTimeout the connection is
+// treated as lost, going through the DisconnectCallback and auto reconnect like any other connection error.  Time
+// spent handling a message on the read loop doesn't count.
+type KeepaliveConfig struct {
+	// PingInterval is how often a ping is sent, 0 disables pings and the read deadline.
+	PingInterval time.Duration
+	// PongTimeout is how long to wait for a pong on top of the PingInterval.
+	PongTimeout time.Duration
+	// WriteTimeout is the deadline for writing a message (including pings), 0 means no deadline.
+	WriteTimeout time.Duration
+}
+
+// DefaultKeepaliveConfig pings every 30 seconds, giving up if there is no pong within another 10 seconds, and allows
+// 10 seconds for writes.
+func DefaultKeepaliveConfig() *KeepaliveConfig {
+	return &KeepaliveConfig{
+		PingInterval: 30 * time.Second,
+		PongTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+}
+
+// SetKeepalive enables pings and read/write deadlines on the websocket, passing nil disables them.  It applies from
+// the next (re)connect.
+func (c *Client) SetKeepalive(config *KeepaliveConfig) {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	c.keepalive = config
+}
+
+func (c *Client) keepaliveConfig() *KeepaliveConfig {
+	c.stateLock.RLock()
+	defer c.stateLock.RUnlock()
+	return c.keepalive
+}
+
+// writeDeadline returns the deadline for a write starting now, or the zero time for none.
+func (k *KeepaliveConfig) writeDeadline() time.Time {
+	if k == nil || k.WriteTimeout <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(k.WriteTimeout)
+}
+
+// extendReadDeadline pushes back the point at which the connection is considered dead.
+func (k *KeepaliveConfig) extendReadDeadline(conn *websocket.Conn) {
+	if k == nil || k.PingInterval <= 0 {
+		return
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(k.PingInterval + k.PongTimeout))
+}
+
+// suspendReadDeadline stops the connection from being considered dead until extendReadDeadline is called again.
+func (k *KeepaliveConfig) suspendReadDeadline(conn *websocket.Conn) {
+	if k == nil || k.PingInterval <= 0 {
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+}
+
+// startKeepalive sets up the read deadline and pong handler for conn and starts sending pings until done is closed.
+func (c *Client) startKeepalive(conn *websocket.Conn, config *KeepaliveConfig, done chan struct{}) {
+	if config == nil || config.PingInterval <= 0 {
+		return
+	}
+	config.extendReadDeadline(conn)
+	conn.SetPongHandler(func(string) error {
+		config.extendReadDeadline(conn)
+		return nil
+	})
+
+	go func() {
+		ticker := time.NewTicker(config.PingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.writeLock.Lock()
+				err := conn.WriteControl(websocket.PingMessage, nil, config.writeDeadline())
+				c.writeLock.Unlock()
+				if err != nil {
+					// the read deadline will pick up that the connection is dead
+					c.logf("unable to ping TAU: %v", err)
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+}
+
+// isTimeout reports whether err is from a read deadline passing.
+func isTimeout(err error) bool {
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
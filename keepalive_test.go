@@ -0,0 +1,150 @@
+package gotau
+
+import (
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newKeepaliveTestServer(t *testing.T, respond bool, pings *int32) (*httptest.Server, string, int) {
+	upgrader := websocket.Upgrader{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close()
+		c.SetPingHandler(func(data string) error {
+			atomic.AddInt32(pings, 1)
+			return c.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+		})
+		_, _, err = c.ReadMessage()
+		require.NoError(t, err)
+		if !respond {
+			// stop reading, so pings are never answered like a half open connection
+			time.Sleep(time.Second)
+			return
+		}
+		for {
+			_, _, err = c.ReadMessage()
+			if err != nil {
+				return
+			}
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return server, host, portNum
+}
+
+func TestClient_KeepaliveHealthy(t *testing.T) {
+	var pings int32
+	server, host, portNum := newKeepaliveTestServer(t, true, &pings)
+	defer server.Close()
+
+	disconnects := make(chan error, 1)
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+		disconnectCallback: func(err error) {
+			disconnects <- err
+		},
+		keepalive: &KeepaliveConfig{
+			PingInterval: 20 * time.Millisecond,
+			PongTimeout:  50 * time.Millisecond,
+			WriteTimeout: time.Second,
+		},
+	}
+	err := client.Reconnect()
+	require.NoError(t, err)
+
+	select {
+	case err := <-disconnects:
+		require.Fail(t, "unexpected disconnect", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	require.True(t, atomic.LoadInt32(&pings) > 2)
+	require.NoError(t, client.Close())
+}
+
+func TestClient_KeepaliveMissedPong(t *testing.T) {
+	var pings int32
+	server, host, portNum := newKeepaliveTestServer(t, false, &pings)
+	defer server.Close()
+
+	errs := make(chan error, 1)
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+		errorCallback: func(err error) {
+			errs <- err
+		},
+	}
+	client.SetKeepalive(&KeepaliveConfig{
+		PingInterval: 20 * time.Millisecond,
+		PongTimeout:  20 * time.Millisecond,
+	})
+	err := client.Reconnect()
+	require.NoError(t, err)
+
+	select {
+	case err := <-errs:
+		require.Equal(t, ErrPongTimeout, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "dead connection was not detected")
+	}
+}
+
+func TestClient_KeepaliveSlowHandler(t *testing.T) {
+	closeCodes := make(chan int, 1)
+	server, host, portNum := newCloseTestServer(t, fmt.Sprintf(testFollowMsg, "FiniteSingularity"), closeCodes)
+	defer server.Close()
+
+	disconnects := make(chan error, 1)
+	handled := make(chan struct{})
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+		disconnectCallback: func(err error) {
+			disconnects <- err
+		},
+		followCallback: func(msg *FollowMsg) {
+			// hold up the read loop for longer than PingInterval + PongTimeout
+			time.Sleep(200 * time.Millisecond)
+			close(handled)
+		},
+		keepalive: &KeepaliveConfig{
+			PingInterval: 20 * time.Millisecond,
+			PongTimeout:  30 * time.Millisecond,
+			WriteTimeout: time.Second,
+		},
+	}
+	err := client.Reconnect()
+	require.NoError(t, err)
+	<-handled
+
+	select {
+	case err := <-disconnects:
+		require.Fail(t, "a slow handler was treated as a dead connection", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	require.NoError(t, client.Close())
+	require.Equal(t, websocket.CloseNormalClosure, <-closeCodes)
+}
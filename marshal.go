@@ -0,0 +1,217 @@
+package gotau
+
+import "encoding/json"
+
+// eventEnvelope is the order TAU sends the parts of an event in, the IDs are pointers so that empty IDs are written as
+// null the same as TAU does for test events.
+type eventEnvelope struct {
+	ID          *string     `json:"id"`
+	EventID     *string     `json:"event_id"`
+	EventType   EventType   `json:"event_type"`
+	EventSource string      `json:"event_source"`
+	EventData   interface{} `json:"event_data"`
+	Created     Time        `json:"created"`
+	Origin      string      `json:"origin"`
+}
+
+// marshalEvent writes an event the same way TAU sends it, so it can be persisted and decoded again later.  Strings
+// that TAU sends as null, such as the user of an anonymous cheer, are written as empty strings as there is no way to
+// tell the difference once they have been decoded.
+func marshalEvent(event *Event, data interface{}) ([]byte, error) {
+	if event == nil {
+		event = new(Event)
+	}
+	envelope := eventEnvelope{
+		ID:          nullString(event.ID),
+		EventID:     nullString(event.EventID),
+		EventType:   event.EventType,
+		EventSource: event.EventSource,
+		EventData:   data,
+		Created:     event.Created,
+		Origin:      event.Origin,
+	}
+	return json.Marshal(envelope)
+}
+
+// nullString returns nil for an empty string so that it is written as null.
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m FollowMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m StreamUpdateMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m CheerMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m RaidMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m SubscriptionMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m HypeTrainBeginMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m HypeTrainProgressMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m HypeTrainEndedMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m StreamOnlineMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m StreamOfflineMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PointsRedemptionMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m ChannelSubscribeMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m SubscriptionEndMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m SubscriptionGiftMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m SubscriptionMessageMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PointsRewardAddMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PointsRewardUpdateMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PointsRewardRemoveMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PointsRedemptionUpdateMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PollBeginMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PollProgressMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PollEndMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PredictionBeginMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PredictionProgressMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PredictionLockMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m PredictionEndMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m BanMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m UnbanMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m ModeratorAddMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m ModeratorRemoveMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m GoalBeginMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m GoalProgressMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m GoalEndMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m CharityCampaignDonateMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
+
+// MarshalJSON writes the message the same way TAU sends it.
+func (m CharityCampaignProgressMsg) MarshalJSON() ([]byte, error) {
+	return marshalEvent(m.Event, m.EventData)
+}
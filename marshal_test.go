@@ -0,0 +1,105 @@
+package gotau
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMarshalJSON_Golden decodes each of the sample payloads in testdata/events, as TAU sends them, and checks that
+// marshalling the message gives back the same JSON.  Files are named after the event type, with an optional variant
+// such as ban.permanent.json.
+func TestMarshalJSON_Golden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "events", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		file := file
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		eventType := EventType(strings.SplitN(name, ".", 2)[0])
+		t.Run(name, func(t *testing.T) {
+			golden, err := ioutil.ReadFile(file)
+			require.NoError(t, err)
+			event, err := decodeEvent(eventType, golden)
+			require.NoError(t, err)
+			require.NotNil(t, event, "no message type registered")
+			require.Equal(t, eventType, event.Type())
+
+			b, err := json.Marshal(event)
+			require.NoError(t, err)
+			require.JSONEq(t, string(golden), string(b))
+
+			again, err := decodeEvent(eventType, b)
+			require.NoError(t, err)
+			require.Equal(t, event, again)
+		})
+	}
+}
+
+func TestMarshalJSON_GoldenAPIModels(t *testing.T) {
+	tests := []struct {
+		file  string
+		model interface{}
+	}{
+		{
+			file:  "stream.json",
+			model: new(TAUStream),
+		},
+		{
+			file:  "stream.live.json",
+			model: new(TAUStream),
+		},
+		{
+			file:  "stream.empty-tags.json",
+			model: new(TAUStream),
+		},
+		{
+			file:  "stream.tag-array.json",
+			model: new(TAUStream),
+		},
+		{
+			file:  "streamer.json",
+			model: new(TAUStreamer),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.file, func(t *testing.T) {
+			golden, err := ioutil.ReadFile(filepath.Join("testdata", "api", test.file))
+			require.NoError(t, err)
+			err = json.Unmarshal(golden, test.model)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(test.model)
+			require.NoError(t, err)
+			require.JSONEq(t, string(golden), string(b))
+		})
+	}
+}
+
+func TestMarshalJSON_EmptyIDs(t *testing.T) {
+	msg := &FollowMsg{
+		Event: &Event{
+			EventType: EventFollow,
+		},
+	}
+	b, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	envelope := make(map[string]interface{})
+	err = json.Unmarshal(b, &envelope)
+	require.NoError(t, err)
+	require.Nil(t, envelope["id"])
+	require.Nil(t, envelope["event_id"])
+	require.Nil(t, envelope["created"])
+	require.Equal(t, "follow", envelope["event_type"])
+
+	msg = new(FollowMsg)
+	b, err = json.Marshal(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"event_data":{`)
+}
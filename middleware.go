@@ -0,0 +1,26 @@
+package gotau
+
+// Handler handles a message from TAU, event is the parsed envelope and msg is the raw message it was parsed from.
+type Handler func(event *Event, msg []byte)
+
+// Middleware wraps a Handler to add behaviour around the handling of every message (e.g. logging, metrics or
+// filtering).  It can stop a message from being dispatched by not calling next, or change it by passing a different
+// event or msg to next.  The raw message is decoded into its specific type after all of the middleware has run, using
+// the event type of event, and the envelope fields of the decoded message are then set from event, so changes to
+// either are seen by the callbacks and handlers.
+type Middleware func(next Handler) Handler
+
+// Use adds middleware in front of the dispatching of messages to the callbacks, handlers and event channels.  The
+// first middleware added is the outermost, so it sees each message first.  The RawCallback is still called with every
+// message before any middleware.
+func (c *Client) Use(middleware ...Middleware) {
+	c.callbackLock.Lock()
+	defer c.callbackLock.Unlock()
+	c.middleware = append(c.middleware, middleware...)
+
+	chain := Handler(c.handleEvent)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		chain = c.middleware[i](chain)
+	}
+	c.chain = chain
+}
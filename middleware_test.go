@@ -0,0 +1,95 @@
+package gotau
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestClient_UseOrder(t *testing.T) {
+	client := Client{}
+	var calls []string
+	middleware := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(event *Event, msg []byte) {
+				calls = append(calls, name+" before")
+				next(event, msg)
+				calls = append(calls, name+" after")
+			}
+		}
+	}
+	client.Use(middleware("first"), middleware("second"))
+	client.OnFollow(func(msg *FollowMsg) {
+		calls = append(calls, "handler")
+	})
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.Equal(t, []string{"first before", "second before", "handler", "second after", "first after"}, calls)
+}
+
+func TestClient_UseFilter(t *testing.T) {
+	client := Client{}
+	rawCalled := 0
+	client.SetRawCallback(func(msg []byte) {
+		rawCalled++
+	})
+	client.Use(func(next Handler) Handler {
+		return func(event *Event, msg []byte) {
+			if event.EventType == EventFollow {
+				return
+			}
+			next(event, msg)
+		}
+	})
+	followCalled := false
+	client.OnFollow(func(msg *FollowMsg) {
+		followCalled = true
+	})
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.False(t, followCalled)
+	require.Equal(t, 1, rawCalled, "raw callback should be called before middleware")
+}
+
+func TestClient_UseTransform(t *testing.T) {
+	client := Client{}
+	client.Use(func(next Handler) Handler {
+		return func(event *Event, msg []byte) {
+			next(event, []byte(strings.Replace(string(msg), "FiniteSingularity", "wwsean08", 1)))
+		}
+	})
+	var userName string
+	client.OnFollow(func(msg *FollowMsg) {
+		userName = msg.EventData.UserName
+	})
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.Equal(t, "wwsean08", userName)
+}
+
+func TestClient_UseTransformEnvelope(t *testing.T) {
+	client := Client{}
+	client.Use(func(next Handler) Handler {
+		return func(event *Event, msg []byte) {
+			changed := *event
+			changed.EventID = "changed"
+			changed.Origin = "middleware"
+			next(&changed, msg)
+		}
+	})
+	var eventID, origin string
+	client.OnFollow(func(msg *FollowMsg) {
+		eventID = msg.EventID
+		origin = msg.Origin
+	})
+	events := client.Events(context.Background())
+
+	client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.Equal(t, "changed", eventID)
+	require.Equal(t, "middleware", origin)
+	event := <-events
+	require.Equal(t, "changed", event.(*FollowMsg).EventID)
+	require.Equal(t, "FiniteSingularity", event.(*FollowMsg).EventData.UserName)
+}
@@ -0,0 +1,208 @@
+package gotau
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// Logger is used by the clients to report connection problems and failed requests, it is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Options holds the configuration used when building a client with NewClientWithOptions, it is shared by this
+// package and the helix package.  Rather than filling it in directly use the With... functions.
+type Options struct {
+	Hostname string
+	Port     int
+	Token    string
+	HasSSL   bool
+	// BasePath is prepended to every path, for when TAU is served from a sub-path behind a reverse proxy.
+	BasePath   string
+	HTTPClient *http.Client
+	Dialer     *websocket.Dialer
+	// Header holds extra headers sent with every REST request and the websocket handshake.
+	Header          http.Header
+	Logger          Logger
+	ReconnectPolicy *ReconnectPolicy
+	WorkerPool      *WorkerPoolConfig
+	Keepalive       *KeepaliveConfig
+	RetryPolicy     *RetryPolicy
+}
+
+// Option configures a client, see the With... functions.
+type Option func(*Options) error
+
+// NewOptions applies the given options and fills in defaults, returning an error if the result isn't usable.
+func NewOptions(opts ...Option) (*Options, error) {
+	options := &Options{
+		Header: make(http.Header),
+	}
+	for _, opt := range opts {
+		err := opt(options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	options.Hostname = strings.TrimSpace(options.Hostname)
+	if options.Hostname == "" {
+		return nil, BadRequestError{
+			Err: "invalid options, hostname can't be blank",
+		}
+	}
+	if options.Port == 0 {
+		options.Port = 80
+		if options.HasSSL {
+			options.Port = 443
+		}
+	}
+	options.BasePath = normalizeBasePath(options.BasePath)
+
+	return options, nil
+}
+
+// WithHost sets the hostname and port TAU is running on.
+func WithHost(hostname string, port int) Option {
+	return func(o *Options) error {
+		o.Hostname = hostname
+		o.Port = port
+		return nil
+	}
+}
+
+// WithToken sets the token used to authenticate with TAU.
+func WithToken(token string) Option {
+	return func(o *Options) error {
+		o.Token = token
+		return nil
+	}
+}
+
+// WithSSL sets whether TAU is served over https/wss.
+func WithSSL(hasSSL bool) Option {
+	return func(o *Options) error {
+		o.HasSSL = hasSSL
+		return nil
+	}
+}
+
+// WithURL sets the hostname, port, ssl and base path from a url such as wss://tau.example.com/prefix.  The
+// ws, wss, http and https schemes are supported, and the port defaults to the one for the scheme.
+func WithURL(rawURL string) Option {
+	return func(o *Options) error {
+		parsed, err := url.Parse(rawURL)
+		if err != nil {
+			return err
+		}
+		switch parsed.Scheme {
+		case "ws", "http":
+			o.HasSSL = false
+		case "wss", "https":
+			o.HasSSL = true
+		default:
+			return BadRequestError{
+				Err: fmt.Sprintf("invalid url, scheme must be one of ws, wss, http or https but was %q", parsed.Scheme),
+			}
+		}
+		o.Hostname = parsed.Hostname()
+		o.Port = 0
+		if parsed.Port() != "" {
+			o.Port, err = strconv.Atoi(parsed.Port())
+			if err != nil {
+				return err
+			}
+		}
+		o.BasePath = parsed.Path
+		return nil
+	}
+}
+
+// WithBasePath sets a path prefix for every request, for when TAU sits behind a reverse proxy on a sub-path.
+func WithBasePath(basePath string) Option {
+	return func(o *Options) error {
+		o.BasePath = basePath
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http client used for REST requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *Options) error {
+		o.HTTPClient = client
+		return nil
+	}
+}
+
+// WithDialer sets the dialer used to open the websocket, which allows for proxies and custom TLS configuration.
+func WithDialer(dialer *websocket.Dialer) Option {
+	return func(o *Options) error {
+		o.Dialer = dialer
+		return nil
+	}
+}
+
+// WithHeader adds a header to every REST request and the websocket handshake.
+func WithHeader(key, value string) Option {
+	return func(o *Options) error {
+		o.Header.Add(key, value)
+		return nil
+	}
+}
+
+// WithLogger sets a logger for the client to report connection problems and failed requests to.
+func WithLogger(logger Logger) Option {
+	return func(o *Options) error {
+		o.Logger = logger
+		return nil
+	}
+}
+
+// WithReconnectPolicy enables automatic reconnecting of the websocket, see SetAutoReconnect.
+func WithReconnectPolicy(policy *ReconnectPolicy) Option {
+	return func(o *Options) error {
+		o.ReconnectPolicy = policy
+		return nil
+	}
+}
+
+// WithWorkerPool processes messages using a bounded worker pool, see SetWorkerPool.
+func WithWorkerPool(config WorkerPoolConfig) Option {
+	return func(o *Options) error {
+		o.WorkerPool = &config
+		return nil
+	}
+}
+
+// WithKeepalive enables pings and read/write deadlines on the websocket, see SetKeepalive.
+func WithKeepalive(config *KeepaliveConfig) Option {
+	return func(o *Options) error {
+		o.Keepalive = config
+		return nil
+	}
+}
+
+// WithRetryPolicy retries REST requests that fail with a transient error, see SetRetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *Options) error {
+		o.RetryPolicy = policy
+		return nil
+	}
+}
+
+func baseURL(protocol, hostname string, port int, basePath string) string {
+	return fmt.Sprintf("%s://%s:%d%s", protocol, hostname, port, basePath)
+}
+
+func normalizeBasePath(basePath string) string {
+	basePath = strings.Trim(strings.TrimSpace(basePath), "/")
+	if basePath == "" {
+		return ""
+	}
+	return "/" + basePath
+}
@@ -0,0 +1,82 @@
+package gotau
+
+import (
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewOptions_WithURL(t *testing.T) {
+	options, err := NewOptions(WithURL("wss://tau.example.com/prefix/"), WithToken("foo"))
+	require.NoError(t, err)
+	require.Equal(t, "tau.example.com", options.Hostname)
+	require.Equal(t, 443, options.Port)
+	require.True(t, options.HasSSL)
+	require.Equal(t, "/prefix", options.BasePath)
+	require.Equal(t, "foo", options.Token)
+
+	options, err = NewOptions(WithURL("http://localhost:8000"))
+	require.NoError(t, err)
+	require.Equal(t, "localhost", options.Hostname)
+	require.Equal(t, 8000, options.Port)
+	require.False(t, options.HasSSL)
+	require.Empty(t, options.BasePath)
+}
+
+func TestNewOptions_WithURLBadScheme(t *testing.T) {
+	_, err := NewOptions(WithURL("ftp://tau.example.com"))
+	require.Error(t, err)
+	require.IsType(t, BadRequestError{}, err)
+}
+
+func TestNewOptions_BlankHostname(t *testing.T) {
+	_, err := NewOptions(WithToken("foo"))
+	require.Error(t, err)
+	require.IsType(t, BadRequestError{}, err)
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	wg := new(sync.WaitGroup)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/prefix/api/v1/streamers/" {
+			require.Equal(t, "bar", r.Header.Get("X-Foo"))
+			require.Equal(t, "Token foo", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte("[]"))
+			return
+		}
+		require.Equal(t, "/prefix/ws/twitch-events/", r.URL.Path)
+		require.Equal(t, "bar", r.Header.Get("X-Foo"))
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close()
+		_, _, err = c.ReadMessage()
+		require.NoError(t, err)
+		wg.Done()
+		_, _, _ = c.ReadMessage()
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	wg.Add(1)
+	client, err := NewClientWithOptions(WithHost(host, portNum), WithToken("foo"), WithBasePath("prefix"),
+		WithHeader("X-Foo", "bar"))
+	require.NoError(t, err)
+	wg.Wait()
+	require.NotNil(t, client.currentConn())
+
+	streamers, err := client.GetStreamers()
+	require.NoError(t, err)
+	require.Empty(t, streamers)
+}
@@ -0,0 +1,98 @@
+package gotau
+
+import (
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_PanicCallback(t *testing.T) {
+	client := Client{}
+	var panics []PanicError
+	client.SetPanicCallback(func(err PanicError) {
+		panics = append(panics, err)
+	})
+	client.SetFollowCallback(func(msg *FollowMsg) {
+		panic("callback")
+	})
+	secondCalled := false
+	client.OnFollow(func(msg *FollowMsg) {
+		panic("handler")
+	})
+	client.OnFollow(func(msg *FollowMsg) {
+		secondCalled = true
+	})
+
+	msg := []byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity"))
+	require.NotPanics(t, func() {
+		client.handleMessage(msg)
+	})
+	require.True(t, secondCalled, "handlers after the panicking one should still be called")
+	require.Len(t, panics, 2)
+	require.Equal(t, "callback", panics[0].Value)
+	require.Equal(t, "handler", panics[1].Value)
+	require.Equal(t, msg, panics[1].Msg)
+	require.NotEmpty(t, panics[1].Stack)
+	require.Equal(t, "recovered from panic: handler", panics[1].Error())
+}
+
+func TestClient_PanicWithoutCallback(t *testing.T) {
+	client := Client{}
+	client.SetRawCallback(func(msg []byte) {
+		panic("raw")
+	})
+	client.Use(func(next Handler) Handler {
+		return func(event *Event, msg []byte) {
+			panic("middleware")
+		}
+	})
+
+	require.NotPanics(t, func() {
+		client.handleMessage([]byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	})
+}
+
+func TestClient_DoneWithoutErrorCallback(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		// read the login then drop the connection
+		_, _, _ = c.ReadMessage()
+		_ = c.Close()
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+	}
+	require.NoError(t, client.Err())
+	err = client.Reconnect()
+	require.NoError(t, err)
+
+	select {
+	case <-client.Done():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "client did not stop after the connection was lost")
+	}
+	require.Error(t, client.Err())
+	require.Equal(t, client.Err(), client.SendMessage("foo"))
+	require.Equal(t, client.Err(), client.Reconnect())
+}
@@ -0,0 +1,38 @@
+package gotau
+
+// PollChoice is a choice in a poll, the same in the TAU websocket events and the helix poll responses.  The vote
+// counts are 0 in poll begin events.
+type PollChoice struct {
+	ID                 string `json:"id"`
+	Title              string `json:"title"`
+	Votes              int    `json:"votes"`
+	ChannelPointsVotes int    `json:"channel_points_votes"`
+	BitsVotes          int    `json:"bits_votes"`
+}
+
+// PollVoting is whether a poll allows extra votes using bits or channel points, and how much each vote costs.
+type PollVoting struct {
+	IsEnabled     bool `json:"is_enabled"`
+	AmountPerVote int  `json:"amount_per_vote"`
+}
+
+// PredictionOutcome is a possible outcome of a prediction, the same in the TAU websocket events and the helix
+// prediction responses.  Users, ChannelPoints and TopPredictors are empty in prediction begin events.
+type PredictionOutcome struct {
+	ID            string          `json:"id"`
+	Title         string          `json:"title"`
+	Color         string          `json:"color"`
+	Users         int             `json:"users"`
+	ChannelPoints int             `json:"channel_points"`
+	TopPredictors []*TopPredictor `json:"top_predictors"`
+}
+
+// TopPredictor is one of the users who used the most channel points on an outcome, the same in the TAU websocket
+// events and the helix prediction responses.  ChannelPointsWon is only set once the prediction has ended.
+type TopPredictor struct {
+	UserID            string `json:"user_id"`
+	UserName          string `json:"user_name"`
+	UserLogin         string `json:"user_login"`
+	ChannelPointsUsed int    `json:"channel_points_used"`
+	ChannelPointsWon  int    `json:"channel_points_won"`
+}
@@ -2,138 +2,349 @@ package gotau
 
 import (
 	"encoding/json"
+	"github.com/gorilla/websocket"
+	"runtime/debug"
 )
 
-func (c *Client) readLoop() {
+func (c *Client) readLoop(conn *websocket.Conn, keepalive *KeepaliveConfig, done chan struct{}) {
 	for {
-		_, message, err := c.conn.ReadMessage()
+		_, message, err := conn.ReadMessage()
 		if err != nil {
-			if c.errorCallback != nil {
-				c.errorCallback(err)
-				return
+			close(done)
+			if keepalive != nil && isTimeout(err) {
+				err = ErrPongTimeout
 			}
-			panic(err.Error())
+			// if the connection was replaced (e.g. by Reconnect) this error is expected, so just exit quietly
+			if c.currentConn() == conn {
+				c.handleDisconnect(err)
+			}
+			return
 		}
+		// pongs aren't read while a slow handler or a full worker pool queue holds up the read loop, which mustn't look
+		// like a dead connection
+		keepalive.suspendReadDeadline(conn)
+		c.processMessage(message)
+		keepalive.extendReadDeadline(conn)
+	}
+}
 
-		if c.parallelProcessing {
-			go c.handleMessage(message)
-		} else {
+// processMessage hands the message to the worker pool, a new goroutine or handles it on the read loop depending on
+// how the client is set up.
+func (c *Client) processMessage(message []byte) {
+	if c.submitMessage(message) {
+		return
+	}
+	if c.parallelProcessing {
+		c.handlerWG.Add(1)
+		go func() {
+			defer c.handlerWG.Done()
 			c.handleMessage(message)
-		}
+		}()
+	} else {
+		c.handleMessage(message)
+	}
+}
+
+// handleDisconnect is called when the read loop of the active connection fails.  If auto reconnect is enabled the
+// connection is re-established, otherwise the error is handed to the ErrorCallback.
+func (c *Client) handleDisconnect(err error) {
+	c.logf("disconnected from TAU: %v", err)
+	c.callbackLock.RLock()
+	disconnectCallback := c.disconnectCallback
+	c.callbackLock.RUnlock()
+	if disconnectCallback != nil {
+		c.safeCall(nil, func() {
+			disconnectCallback(err)
+		})
+	}
+
+	c.stateLock.RLock()
+	policy := c.reconnectPolicy
+	generation := c.generation
+	c.stateLock.RUnlock()
+	if policy != nil {
+		c.superviseReconnect(policy, generation)
+		return
+	}
+	c.handleError(err)
+}
+
+func (c *Client) handleError(err error) {
+	c.callbackLock.RLock()
+	errorCallback := c.errorCallback
+	c.callbackLock.RUnlock()
+	if errorCallback != nil {
+		c.safeCall(nil, func() {
+			errorCallback(err)
+		})
+		return
 	}
+	c.logf("TAU client stopped: %v", err)
+	c.terminate(err)
+}
+
+// safeCall calls fn, recovering from any panic and reporting it to the PanicCallback along with the message that was
+// being handled.
+func (c *Client) safeCall(msg []byte, fn func()) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		panicErr := PanicError{
+			Value: recovered,
+			Stack: debug.Stack(),
+			Msg:   msg,
+		}
+		c.callbackLock.RLock()
+		panicCallback := c.panicCallback
+		c.callbackLock.RUnlock()
+		if panicCallback == nil {
+			c.logf("%v\n%s", panicErr, panicErr.Stack)
+			return
+		}
+		// a panicking PanicCallback is dropped rather than taking down the client
+		defer func() {
+			_ = recover()
+		}()
+		panicCallback(panicErr)
+	}()
+	fn()
 }
 
 func (c *Client) handleMessage(msg []byte) {
-	if c.rawCallback != nil {
-		c.rawCallback(msg)
+	c.callbackLock.RLock()
+	rawCallback := c.rawCallback
+	c.callbackLock.RUnlock()
+	if rawCallback != nil {
+		c.safeCall(msg, func() {
+			rawCallback(msg)
+		})
 	}
 
 	event := new(Event)
 	err := json.Unmarshal(msg, event)
 	if err != nil {
-		// Not much we can do here, skip
+		c.handleDecodeError(err, "", msg)
 		return
 	}
 
-	switch event.EventType {
-	case follow:
-		if c.followCallback != nil {
-			followMsg := new(FollowMsg)
-			err = json.Unmarshal(msg, followMsg)
-			if err != nil {
-				return
-			}
-			c.followCallback(followMsg)
-		}
-	case update:
-		if c.streamUpdateCallback != nil {
-			updateMsg := new(StreamUpdateMsg)
-			err = json.Unmarshal(msg, updateMsg)
-			if err != nil {
-				return
-			}
-			c.streamUpdateCallback(updateMsg)
-		}
-	case cheer:
-		if c.cheerCallback != nil {
-			cheerMsg := new(CheerMsg)
-			err = json.Unmarshal(msg, cheerMsg)
-			if err != nil {
-				return
-			}
-			c.cheerCallback(cheerMsg)
-		}
-	case raid:
-		if c.raidCallback != nil {
-			raidMsg := new(RaidMsg)
-			err = json.Unmarshal(msg, raidMsg)
-			if err != nil {
-				return
-			}
-			c.raidCallback(raidMsg)
-		}
-	case subscription:
-		if c.subscriptionCallback != nil {
-			subMsg := new(SubscriptionMsg)
-			err = json.Unmarshal(msg, subMsg)
-			if err != nil {
-				return
-			}
-			c.subscriptionCallback(subMsg)
-		}
-	case pointsRedemption:
-		if c.pointsRedemptionCallback != nil {
-			pointsMsg := new(PointsRedemptionMsg)
-			err = json.Unmarshal(msg, pointsMsg)
-			if err != nil {
-				return
-			}
-			c.pointsRedemptionCallback(pointsMsg)
-		}
-	case hypeBegin:
-		if c.hypeTrainBeginCallback != nil {
-			hypeMsg := new(HypeTrainBeginMsg)
-			err = json.Unmarshal(msg, hypeMsg)
-			if err != nil {
-				return
-			}
-			c.hypeTrainBeginCallback(hypeMsg)
-		}
-	case hypeProgress:
-		if c.hypeTrainProgressCallback != nil {
-			hypeMsg := new(HypeTrainProgressMsg)
-			err = json.Unmarshal(msg, hypeMsg)
-			if err != nil {
-				return
-			}
-			c.hypeTrainProgressCallback(hypeMsg)
-		}
-	case hypeEnd:
-		if c.hypeTrainEndedCallback != nil {
-			hypeMsg := new(HypeTrainEndedMsg)
-			err = json.Unmarshal(msg, hypeMsg)
-			if err != nil {
-				return
-			}
-			c.hypeTrainEndedCallback(hypeMsg)
-		}
-	case streamOnline:
-		if c.streamOnlineCallback != nil {
-			onlineMsg := new(StreamOnlineMsg)
-			err = json.Unmarshal(msg, onlineMsg)
-			if err != nil {
-				return
-			}
-			c.streamOnlineCallback(onlineMsg)
-		}
-	case streamOffline:
-		if c.streamOfflineCallback != nil {
-			offlineMsg := new(StreamOfflineMsg)
-			err = json.Unmarshal(msg, offlineMsg)
-			if err != nil {
-				return
-			}
-			c.streamOfflineCallback(offlineMsg)
+	c.callbackLock.RLock()
+	chain := c.chain
+	c.callbackLock.RUnlock()
+	if chain == nil {
+		chain = c.handleEvent
+	}
+	c.safeCall(msg, func() {
+		chain(event, msg)
+	})
+}
+
+// handleEvent is the end of the middleware chain, decoding the message into its specific type and passing it on to the
+// event channels, callbacks and handlers.
+func (c *Client) handleEvent(event *Event, msg []byte) {
+	tauEvent, err := decodeEvent(event.EventType, msg)
+	if err != nil {
+		c.handleDecodeError(err, event.EventType, msg)
+		return
+	}
+	if tauEvent == nil {
+		c.callbackLock.RLock()
+		unknownEventCallback := c.unknownEventCallback
+		c.callbackLock.RUnlock()
+		if unknownEventCallback != nil {
+			c.safeCall(msg, func() {
+				unknownEventCallback(event, msg)
+			})
+		}
+		return
+	}
+	// the envelope may have been changed by middleware, which takes precedence over what is in msg
+	if enveloped, ok := tauEvent.(interface{ envelope() *Event }); ok {
+		if envelope := enveloped.envelope(); envelope != nil {
+			*envelope = *event
+		}
+	}
+	c.publish(tauEvent)
+	c.dispatch(event.EventType, tauEvent, msg)
+}
+
+// handleDecodeError passes a message that couldn't be parsed to the DecodeErrorCallback, or skips it if there isn't
+// one.
+func (c *Client) handleDecodeError(err error, eventType EventType, msg []byte) {
+	c.callbackLock.RLock()
+	decodeErrorCallback := c.decodeErrorCallback
+	c.callbackLock.RUnlock()
+	if decodeErrorCallback != nil {
+		c.safeCall(msg, func() {
+			decodeErrorCallback(err, eventType, msg)
+		})
+	}
+}
+
+// dispatch calls the callback set for the event, followed by the handlers registered for its event type in the order
+// they were registered.  Each one is called with safeCall so a panic in one doesn't stop the rest.
+func (c *Client) dispatch(eventType EventType, tauEvent TAUEvent, msg []byte) {
+	c.callbackLock.RLock()
+	callback := c.callbackFor(tauEvent)
+	handlers := c.handlers[eventType]
+	c.callbackLock.RUnlock()
+
+	if callback != nil {
+		c.safeCall(msg, callback)
+	}
+	for _, handler := range handlers {
+		fn := handler.fn
+		c.safeCall(msg, func() {
+			fn(tauEvent)
+		})
+	}
+}
+
+// callbackFor returns the callback set for the event bound to it, or nil if there isn't one.  callbackLock must be
+// held.
+func (c *Client) callbackFor(tauEvent TAUEvent) func() {
+	switch eventMsg := tauEvent.(type) {
+	case *FollowMsg:
+		if callback := c.followCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *StreamUpdateMsg:
+		if callback := c.streamUpdateCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *CheerMsg:
+		if callback := c.cheerCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *RaidMsg:
+		if callback := c.raidCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *SubscriptionMsg:
+		if callback := c.subscriptionCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PointsRedemptionMsg:
+		if callback := c.pointsRedemptionCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *HypeTrainBeginMsg:
+		if callback := c.hypeTrainBeginCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *HypeTrainProgressMsg:
+		if callback := c.hypeTrainProgressCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *HypeTrainEndedMsg:
+		if callback := c.hypeTrainEndedCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *StreamOnlineMsg:
+		if callback := c.streamOnlineCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *StreamOfflineMsg:
+		if callback := c.streamOfflineCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *ChannelSubscribeMsg:
+		if callback := c.channelSubscribeCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *SubscriptionEndMsg:
+		if callback := c.subscriptionEndCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *SubscriptionGiftMsg:
+		if callback := c.subscriptionGiftCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *SubscriptionMessageMsg:
+		if callback := c.subscriptionMessageCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PointsRewardAddMsg:
+		if callback := c.pointsRewardAddCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PointsRewardUpdateMsg:
+		if callback := c.pointsRewardUpdateCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PointsRewardRemoveMsg:
+		if callback := c.pointsRewardRemoveCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PointsRedemptionUpdateMsg:
+		if callback := c.pointsRedemptionUpdateCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PollBeginMsg:
+		if callback := c.pollBeginCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PollProgressMsg:
+		if callback := c.pollProgressCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PollEndMsg:
+		if callback := c.pollEndCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PredictionBeginMsg:
+		if callback := c.predictionBeginCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PredictionProgressMsg:
+		if callback := c.predictionProgressCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PredictionLockMsg:
+		if callback := c.predictionLockCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *PredictionEndMsg:
+		if callback := c.predictionEndCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *BanMsg:
+		if callback := c.banCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *UnbanMsg:
+		if callback := c.unbanCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *ModeratorAddMsg:
+		if callback := c.moderatorAddCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *ModeratorRemoveMsg:
+		if callback := c.moderatorRemoveCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *GoalBeginMsg:
+		if callback := c.goalBeginCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *GoalProgressMsg:
+		if callback := c.goalProgressCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *GoalEndMsg:
+		if callback := c.goalEndCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *CharityCampaignDonateMsg:
+		if callback := c.charityCampaignDonateCallback; callback != nil {
+			return func() { callback(eventMsg) }
+		}
+	case *CharityCampaignProgressMsg:
+		if callback := c.charityCampaignProgressCallback; callback != nil {
+			return func() { callback(eventMsg) }
 		}
 	}
+	return nil
 }
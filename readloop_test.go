@@ -150,7 +150,7 @@ func TestHandleMessage_FollowEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "69db248a-b980-4de6-ad06-b528feb1294e", msg.EventID)
-		require.Equal(t, follow, msg.EventType)
+		require.Equal(t, EventFollow, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -183,7 +183,7 @@ func TestHandleMessage_UpdateEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "286244d9-c382-4a6e-81ed-5e80bcd2c94e", msg.EventID)
-		require.Equal(t, update, msg.EventType)
+		require.Equal(t, EventStreamUpdate, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -216,7 +216,7 @@ func TestHandleMessage_CheerEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "ae567342-62c8-4b45-a41b-7da1472003b9", msg.EventID)
-		require.Equal(t, cheer, msg.EventType)
+		require.Equal(t, EventCheer, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -252,7 +252,7 @@ func TestHandleMessage_RaidEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "3e62303f-55d3-478d-8f09-c83712e7c3b8", msg.EventID)
-		require.Equal(t, raid, msg.EventType)
+		require.Equal(t, EventRaid, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -285,7 +285,7 @@ func TestHandleMessage_SubEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Zero(t, msg.EventID)
-		require.Equal(t, subscription, msg.EventType)
+		require.Equal(t, EventSubscription, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -314,7 +314,7 @@ func TestHandleMessage_SubEvent(t *testing.T) {
 		require.Equal(t, 5, message.Time.Hour())
 		require.Equal(t, 20, message.Time.Minute())
 		require.Equal(t, 6, message.Time.Second())
-		require.Equal(t, "1000", message.SubPlan)
+		require.Equal(t, SubTier1, message.SubPlan)
 		require.Equal(t, "Channel Subscription (wwsean08)", message.SubPlanName)
 		require.Equal(t, 0, message.Months)
 		require.Equal(t, 42, message.CumulativeMonths)
@@ -343,7 +343,7 @@ func TestHandleMessage_StreamOnlineEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "78e4825d-7496-44a5-b4f2-d71af9f040d8", msg.EventID)
-		require.Equal(t, "stream-online", msg.EventType)
+		require.Equal(t, EventStreamOnline, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -358,7 +358,7 @@ func TestHandleMessage_StreamOnlineEvent(t *testing.T) {
 		require.Equal(t, "1337", msg.EventData.BroadcasterID)
 		require.Equal(t, "cool_user", msg.EventData.BroadcasterLogin)
 		require.Equal(t, "Cool_User", msg.EventData.BroadcasterName)
-		require.Equal(t, "live", msg.EventData.Type)
+		require.Equal(t, StreamLive, msg.EventData.Type)
 
 		startedAt := msg.EventData.StartedAt
 		require.Equal(t, 2020, startedAt.Year())
@@ -383,7 +383,7 @@ func TestHandleMessage_StreamOfflineEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "097896c2-9de8-4840-bcdf-7bc5cada9c28", msg.EventID)
-		require.Equal(t, "stream-offline", msg.EventType)
+		require.Equal(t, EventStreamOffline, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -434,14 +434,14 @@ func TestHandleMessage_HypeTrainBeginEvent(t *testing.T) {
 		require.Equal(t, "123", top.UserID)
 		require.Equal(t, "pogchamp", top.UserLogin)
 		require.Equal(t, "PogChamp", top.UserName)
-		require.Equal(t, "bits", top.Type)
+		require.Equal(t, ContributionBits, top.Type)
 		require.Equal(t, 50, top.Total)
 
 		last := msg.EventData.LastContribution
 		require.Equal(t, "123", last.UserID)
 		require.Equal(t, "pogchamp", last.UserLogin)
 		require.Equal(t, "PogChamp", last.UserName)
-		require.Equal(t, "bits", last.Type)
+		require.Equal(t, ContributionBits, last.Type)
 		require.Equal(t, 50, last.Total)
 
 		started := msg.EventData.StartedAt
@@ -475,7 +475,7 @@ func TestHandleMessage_HypeTrainProgressEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "25c54242-154e-44d0-8d52-8a91c783bca4", msg.EventID)
-		require.Equal(t, "hype-train-progress", msg.EventType)
+		require.Equal(t, EventHypeTrainProgress, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -498,14 +498,14 @@ func TestHandleMessage_HypeTrainProgressEvent(t *testing.T) {
 		require.Equal(t, "123", top.UserID)
 		require.Equal(t, "pogchamp", top.UserLogin)
 		require.Equal(t, "PogChamp", top.UserName)
-		require.Equal(t, "bits", top.Type)
+		require.Equal(t, ContributionBits, top.Type)
 		require.Equal(t, 50, top.Total)
 
 		last := msg.EventData.LastContribution
 		require.Equal(t, "123", last.UserID)
 		require.Equal(t, "pogchamp", last.UserLogin)
 		require.Equal(t, "PogChamp", last.UserName)
-		require.Equal(t, "bits", last.Type)
+		require.Equal(t, ContributionBits, last.Type)
 		require.Equal(t, 50, last.Total)
 
 		started := msg.EventData.StartedAt
@@ -539,7 +539,7 @@ func TestHandleMessage_HypeTrainEndEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "f46bdbdc-4890-4199-9e7d-34f4ee9c2bdd", msg.EventID)
-		require.Equal(t, "hype-train-end", msg.EventType)
+		require.Equal(t, EventHypeTrainEnd, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -559,7 +559,7 @@ func TestHandleMessage_HypeTrainEndEvent(t *testing.T) {
 		require.Equal(t, "123", top.UserID)
 		require.Equal(t, "pogchamp", top.UserLogin)
 		require.Equal(t, "PogChamp", top.UserName)
-		require.Equal(t, "bits", top.Type)
+		require.Equal(t, ContributionBits, top.Type)
 		require.Equal(t, 50, top.Total)
 
 		started := msg.EventData.StartedAt
@@ -601,7 +601,7 @@ func TestHandleMessage_PointsRedemptionEvent(t *testing.T) {
 		require.NotNil(t, msg)
 		require.Zero(t, msg.ID)
 		require.Equal(t, "4dc3e5c5-3e38-4d2d-bfe2-9c89c65a8c2a", msg.EventID)
-		require.Equal(t, "point-redemption", msg.EventType)
+		require.Equal(t, EventPointsRedemption, msg.EventType)
 		require.Equal(t, "TestCall", msg.EventSource)
 		require.Equal(t, "test", msg.Origin)
 		require.Equal(t, 2021, msg.Created.Year())
@@ -618,7 +618,7 @@ func TestHandleMessage_PointsRedemptionEvent(t *testing.T) {
 		require.Equal(t, "finitesingularity", msg.EventData.UserLogin)
 		require.Equal(t, "1234", msg.EventData.UserID)
 		require.Empty(t, msg.EventData.UserInput)
-		require.Equal(t, "unfilled", msg.EventData.Status)
+		require.Equal(t, RedemptionStatus("unfilled"), msg.EventData.Status)
 
 		redeemedAt := msg.EventData.RedeemedAt
 		require.Equal(t, 2021, redeemedAt.Year())
@@ -772,3 +772,620 @@ func TestClient_ReadLoopOnError(t *testing.T) {
 
 	require.Equal(t, 1, called)
 }
+
+func TestHandleMessage_UnknownEvent(t *testing.T) {
+	client := Client{}
+	msg := []byte("{\"id\":null,\"event_id\":\"69db248a-b980-4de6-ad06-b528feb1294e\",\"event_type\":\"brand-new-event\",\"event_source\":\"TestCall\",\"event_data\":{\"foo\":\"bar\"},\"created\":\"2021-05-22T05:16:21.506340+00:00\",\"origin\":\"test\"}")
+	called := false
+	client.SetUnknownEventCallback(func(event *Event, raw []byte) {
+		called = true
+		require.Equal(t, EventType("brand-new-event"), event.EventType)
+		require.Equal(t, "69db248a-b980-4de6-ad06-b528feb1294e", event.EventID)
+		require.Equal(t, msg, raw)
+	})
+	client.SetDecodeErrorCallback(func(err error, eventType EventType, raw []byte) {
+		require.Fail(t, "unexpected decode error", err)
+	})
+
+	client.handleMessage(msg)
+	require.True(t, called)
+}
+
+func TestHandleMessage_DecodeError(t *testing.T) {
+	client := Client{}
+	type decodeError struct {
+		err       error
+		eventType EventType
+		msg       []byte
+	}
+	var errs []decodeError
+	client.SetDecodeErrorCallback(func(err error, eventType EventType, msg []byte) {
+		errs = append(errs, decodeError{err: err, eventType: eventType, msg: msg})
+	})
+	client.SetFollowCallback(func(msg *FollowMsg) {
+		require.Fail(t, "follow callback should not be called")
+	})
+
+	badEnvelope := []byte("not json")
+	badFollow := []byte("{\"event_type\":\"follow\",\"event_data\":\"not an object\"}")
+	client.handleMessage(badEnvelope)
+	client.handleMessage(badFollow)
+
+	require.Len(t, errs, 2)
+	require.Error(t, errs[0].err)
+	require.Empty(t, errs[0].eventType)
+	require.Equal(t, badEnvelope, errs[0].msg)
+	require.Error(t, errs[1].err)
+	require.Equal(t, EventFollow, errs[1].eventType)
+	require.Equal(t, badFollow, errs[1].msg)
+}
+
+func TestHandleMessage_ChannelSubscribeEvent(t *testing.T) {
+	called := false
+	callback := func(msg *ChannelSubscribeMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "a3b1c2d4-0000-4e5f-8a9b-000000000001", msg.EventID)
+		require.Equal(t, EventChannelSubscribe, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "finitesingularity", msg.EventData.UserLogin)
+		require.Equal(t, "FiniteSingularity", msg.EventData.UserName)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterLogin)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterName)
+		require.Equal(t, SubTier1, msg.EventData.Tier)
+		require.False(t, msg.EventData.IsGift)
+	}
+	client := Client{
+		channelSubscribeCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"a3b1c2d4-0000-4e5f-8a9b-000000000001\",\"event_type\":\"channel-subscribe\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"tier\":\"1000\",\"is_gift\":false},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_SubscriptionEndEvent(t *testing.T) {
+	called := false
+	callback := func(msg *SubscriptionEndMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "a3b1c2d4-0000-4e5f-8a9b-000000000002", msg.EventID)
+		require.Equal(t, EventSubscriptionEnd, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, SubTier2, msg.EventData.Tier)
+		require.True(t, msg.EventData.IsGift)
+	}
+	client := Client{
+		subscriptionEndCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"a3b1c2d4-0000-4e5f-8a9b-000000000002\",\"event_type\":\"channel-subscription-end\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"tier\":\"2000\",\"is_gift\":true},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_SubscriptionGiftEvent(t *testing.T) {
+	called := false
+	callback := func(msg *SubscriptionGiftMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "a3b1c2d4-0000-4e5f-8a9b-000000000003", msg.EventID)
+		require.Equal(t, EventSubscriptionGift, msg.EventType)
+		require.Equal(t, "FiniteSingularity", msg.EventData.UserName)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterName)
+		require.Equal(t, 5, msg.EventData.Total)
+		require.Equal(t, SubTier1, msg.EventData.Tier)
+		require.Equal(t, 42, msg.EventData.CumulativeTotal)
+		require.False(t, msg.EventData.IsAnonymous)
+	}
+	client := Client{
+		subscriptionGiftCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"a3b1c2d4-0000-4e5f-8a9b-000000000003\",\"event_type\":\"channel-subscription-gift\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"total\":5,\"tier\":\"1000\",\"cumulative_total\":42,\"is_anonymous\":false},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_SubscriptionGiftAnonymousEvent(t *testing.T) {
+	called := false
+	callback := func(msg *SubscriptionGiftMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "a3b1c2d4-0000-4e5f-8a9b-000000000004", msg.EventID)
+		require.Equal(t, EventSubscriptionGift, msg.EventType)
+		require.Empty(t, msg.EventData.UserID)
+		require.Empty(t, msg.EventData.UserName)
+		require.Equal(t, 1, msg.EventData.Total)
+		require.Equal(t, SubTier3, msg.EventData.Tier)
+		require.Zero(t, msg.EventData.CumulativeTotal)
+		require.True(t, msg.EventData.IsAnonymous)
+	}
+	client := Client{
+		subscriptionGiftCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"a3b1c2d4-0000-4e5f-8a9b-000000000004\",\"event_type\":\"channel-subscription-gift\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":null,\"user_login\":null,\"user_name\":null,\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"total\":1,\"tier\":\"3000\",\"cumulative_total\":null,\"is_anonymous\":true},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_SubscriptionMessageEvent(t *testing.T) {
+	called := false
+	callback := func(msg *SubscriptionMessageMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "a3b1c2d4-0000-4e5f-8a9b-000000000005", msg.EventID)
+		require.Equal(t, EventSubscriptionMessage, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, SubTier1, msg.EventData.Tier)
+		require.Equal(t, "Love the stream! FevziGG", msg.EventData.Message.Text)
+		require.Len(t, msg.EventData.Message.Emotes, 1)
+		require.Equal(t, 23, msg.EventData.Message.Emotes[0].Begin)
+		require.Equal(t, 30, msg.EventData.Message.Emotes[0].End)
+		require.Equal(t, "302976485", msg.EventData.Message.Emotes[0].ID)
+		require.Equal(t, 15, msg.EventData.CumulativeMonths)
+		require.Equal(t, 1, msg.EventData.StreakMonths)
+		require.Equal(t, 6, msg.EventData.DurationMonths)
+	}
+	client := Client{
+		subscriptionMessageCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"a3b1c2d4-0000-4e5f-8a9b-000000000005\",\"event_type\":\"channel-subscription-message\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"tier\":\"1000\",\"message\":{\"text\":\"Love the stream! FevziGG\",\"emotes\":[{\"begin\":23,\"end\":30,\"id\":\"302976485\"}]},\"cumulative_months\":15,\"streak_months\":1,\"duration_months\":6},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PointsRewardAddEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PointsRewardAddMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "b4c2d3e5-0000-4e5f-8a9b-000000000001", msg.EventID)
+		require.Equal(t, EventPointsRewardAdd, msg.EventType)
+		require.Equal(t, "9001", msg.EventData.ID)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterLogin)
+		require.Equal(t, "Hydrate", msg.EventData.Title)
+		require.Equal(t, 500, msg.EventData.Cost)
+		require.Equal(t, "Drink some water", msg.EventData.Prompt)
+		require.True(t, msg.EventData.IsEnabled)
+		require.True(t, msg.EventData.IsUserInputRequired)
+		require.Nil(t, msg.EventData.CooldownExpiresAt)
+		require.Equal(t, 3, msg.EventData.RedemptionsRedeemedCurrentStream)
+		require.True(t, msg.EventData.MaxPerStream.IsEnabled)
+		require.Equal(t, 10, msg.EventData.MaxPerStream.Value)
+		require.False(t, msg.EventData.MaxPerUserPerStream.IsEnabled)
+		require.Equal(t, 60, msg.EventData.GlobalCooldown.Seconds)
+		require.Equal(t, "#FA1ED2", msg.EventData.BackgroundColor)
+		require.NotNil(t, msg.EventData.Image)
+		require.Equal(t, "https://static-cdn.jtvnw.net/image-4.png", msg.EventData.Image.Url4X)
+		require.Equal(t, "https://static-cdn.jtvnw.net/default-1.png", msg.EventData.DefaultImage.Url1X)
+	}
+	client := Client{
+		pointsRewardAddCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"b4c2d3e5-0000-4e5f-8a9b-000000000001\",\"event_type\":\"point-reward-add\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"9001\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"is_enabled\":true,\"is_paused\":false,\"is_in_stock\":true,\"title\":\"Hydrate\",\"cost\":500,\"prompt\":\"Drink some water\",\"is_user_input_required\":true,\"should_redemptions_skip_request_queue\":false,\"cooldown_expires_at\":null,\"redemptions_redeemed_current_stream\":3,\"max_per_stream\":{\"is_enabled\":true,\"value\":10},\"max_per_user_per_stream\":{\"is_enabled\":false,\"value\":0},\"global_cooldown\":{\"is_enabled\":true,\"seconds\":60},\"background_color\":\"#FA1ED2\",\"image\":{\"url_1x\":\"https://static-cdn.jtvnw.net/image-1.png\",\"url_2x\":\"https://static-cdn.jtvnw.net/image-2.png\",\"url_4x\":\"https://static-cdn.jtvnw.net/image-4.png\"},\"default_image\":{\"url_1x\":\"https://static-cdn.jtvnw.net/default-1.png\",\"url_2x\":\"https://static-cdn.jtvnw.net/default-2.png\",\"url_4x\":\"https://static-cdn.jtvnw.net/default-4.png\"}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PointsRewardUpdateEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PointsRewardUpdateMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "b4c2d3e5-0000-4e5f-8a9b-000000000002", msg.EventID)
+		require.Equal(t, EventPointsRewardUpdate, msg.EventType)
+		require.Equal(t, "9001", msg.EventData.ID)
+		require.Equal(t, "Hydrate!", msg.EventData.Title)
+		require.Equal(t, 1000, msg.EventData.Cost)
+		require.True(t, msg.EventData.IsPaused)
+		require.NotNil(t, msg.EventData.CooldownExpiresAt)
+		require.Equal(t, 5, msg.EventData.CooldownExpiresAt.Hour())
+	}
+	client := Client{
+		pointsRewardUpdateCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"b4c2d3e5-0000-4e5f-8a9b-000000000002\",\"event_type\":\"point-reward-update\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"9001\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"is_enabled\":true,\"is_paused\":true,\"is_in_stock\":true,\"title\":\"Hydrate!\",\"cost\":1000,\"prompt\":\"Drink some water\",\"is_user_input_required\":true,\"should_redemptions_skip_request_queue\":false,\"cooldown_expires_at\":\"2021-07-16T05:00:00Z\",\"redemptions_redeemed_current_stream\":3,\"max_per_stream\":{\"is_enabled\":true,\"value\":10},\"max_per_user_per_stream\":{\"is_enabled\":false,\"value\":0},\"global_cooldown\":{\"is_enabled\":true,\"seconds\":60},\"background_color\":\"#FA1ED2\",\"image\":{\"url_1x\":\"https://static-cdn.jtvnw.net/image-1.png\",\"url_2x\":\"https://static-cdn.jtvnw.net/image-2.png\",\"url_4x\":\"https://static-cdn.jtvnw.net/image-4.png\"},\"default_image\":{\"url_1x\":\"https://static-cdn.jtvnw.net/default-1.png\",\"url_2x\":\"https://static-cdn.jtvnw.net/default-2.png\",\"url_4x\":\"https://static-cdn.jtvnw.net/default-4.png\"}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PointsRewardRemoveEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PointsRewardRemoveMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "b4c2d3e5-0000-4e5f-8a9b-000000000003", msg.EventID)
+		require.Equal(t, EventPointsRewardRemove, msg.EventType)
+		require.Equal(t, "9001", msg.EventData.ID)
+		require.Equal(t, "Hydrate", msg.EventData.Title)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterName)
+	}
+	client := Client{
+		pointsRewardRemoveCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"b4c2d3e5-0000-4e5f-8a9b-000000000003\",\"event_type\":\"point-reward-remove\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"9001\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"is_enabled\":true,\"is_paused\":false,\"is_in_stock\":true,\"title\":\"Hydrate\",\"cost\":500,\"prompt\":\"Drink some water\",\"is_user_input_required\":true,\"should_redemptions_skip_request_queue\":false,\"cooldown_expires_at\":null,\"redemptions_redeemed_current_stream\":3,\"max_per_stream\":{\"is_enabled\":true,\"value\":10},\"max_per_user_per_stream\":{\"is_enabled\":false,\"value\":0},\"global_cooldown\":{\"is_enabled\":true,\"seconds\":60},\"background_color\":\"#FA1ED2\",\"image\":{\"url_1x\":\"https://static-cdn.jtvnw.net/image-1.png\",\"url_2x\":\"https://static-cdn.jtvnw.net/image-2.png\",\"url_4x\":\"https://static-cdn.jtvnw.net/image-4.png\"},\"default_image\":{\"url_1x\":\"https://static-cdn.jtvnw.net/default-1.png\",\"url_2x\":\"https://static-cdn.jtvnw.net/default-2.png\",\"url_4x\":\"https://static-cdn.jtvnw.net/default-4.png\"}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PointsRedemptionUpdateEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PointsRedemptionUpdateMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "b4c2d3e5-0000-4e5f-8a9b-000000000004", msg.EventID)
+		require.Equal(t, EventPointsRedemptionUpdate, msg.EventType)
+		require.Equal(t, "17fa2df1-ad76-4804-bfa5-a40ef63efe63", msg.EventData.ID)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "FiniteSingularity", msg.EventData.UserName)
+		require.Equal(t, RedemptionFulfilled, msg.EventData.Status)
+		require.Equal(t, "9001", msg.EventData.Reward.ID)
+		require.Equal(t, "Hydrate", msg.EventData.Reward.Title)
+		require.Equal(t, 500, msg.EventData.Reward.Cost)
+		require.Equal(t, 2021, msg.EventData.RedeemedAt.Year())
+	}
+	client := Client{
+		pointsRedemptionUpdateCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"b4c2d3e5-0000-4e5f-8a9b-000000000004\",\"event_type\":\"point-redemption-update\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"17fa2df1-ad76-4804-bfa5-a40ef63efe63\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"user_input\":\"\",\"status\":\"fulfilled\",\"reward\":{\"id\":\"9001\",\"title\":\"Hydrate\",\"cost\":500,\"prompt\":\"Drink some water\"},\"redeemed_at\":\"2021-07-16T04:55:40.231592Z\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PollBeginEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PollBeginMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000001", msg.EventID)
+		require.Equal(t, EventPollBegin, msg.EventType)
+		require.Equal(t, "1243456", msg.EventData.ID)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, "Will we win?", msg.EventData.Title)
+		require.Len(t, msg.EventData.Choices, 2)
+		require.Equal(t, "c1", msg.EventData.Choices[0].ID)
+		require.Equal(t, "Yes", msg.EventData.Choices[0].Title)
+		require.True(t, msg.EventData.BitsVoting.IsEnabled)
+		require.Equal(t, 10, msg.EventData.BitsVoting.AmountPerVote)
+		require.False(t, msg.EventData.ChannelPointsVoting.IsEnabled)
+		require.Equal(t, 5, msg.EventData.EndsAt.Hour())
+	}
+	client := Client{
+		pollBeginCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000001\",\"event_type\":\"poll-begin\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"choices\":[{\"id\":\"c1\",\"title\":\"Yes\",\"votes\":0,\"channel_points_votes\":0,\"bits_votes\":0},{\"id\":\"c2\",\"title\":\"No\",\"votes\":0,\"channel_points_votes\":0,\"bits_votes\":0}],\"started_at\":\"2021-07-16T04:55:40Z\",\"ends_at\":\"2021-07-16T05:00:40Z\",\"bits_voting\":{\"is_enabled\":true,\"amount_per_vote\":10},\"channel_points_voting\":{\"is_enabled\":false,\"amount_per_vote\":0}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PollProgressEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PollProgressMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000002", msg.EventID)
+		require.Equal(t, EventPollProgress, msg.EventType)
+		require.Equal(t, "1243456", msg.EventData.ID)
+		require.Equal(t, 12, msg.EventData.Choices[0].Votes)
+		require.Equal(t, 2, msg.EventData.Choices[0].ChannelPointsVotes)
+		require.Equal(t, 10, msg.EventData.Choices[0].BitsVotes)
+		require.Equal(t, 0, msg.EventData.Choices[1].Votes)
+	}
+	client := Client{
+		pollProgressCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000002\",\"event_type\":\"poll-progress\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"choices\":[{\"id\":\"c1\",\"title\":\"Yes\",\"votes\":12,\"channel_points_votes\":2,\"bits_votes\":10},{\"id\":\"c2\",\"title\":\"No\",\"votes\":0,\"channel_points_votes\":0,\"bits_votes\":0}],\"started_at\":\"2021-07-16T04:55:40Z\",\"ends_at\":\"2021-07-16T05:00:40Z\",\"bits_voting\":{\"is_enabled\":true,\"amount_per_vote\":10},\"channel_points_voting\":{\"is_enabled\":false,\"amount_per_vote\":0}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PollEndEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PollEndMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000003", msg.EventID)
+		require.Equal(t, EventPollEnd, msg.EventType)
+		require.Equal(t, PollCompleted, msg.EventData.Status)
+		require.Equal(t, 20, msg.EventData.Choices[0].Votes)
+		require.Equal(t, 5, msg.EventData.EndedAt.Hour())
+	}
+	client := Client{
+		pollEndCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000003\",\"event_type\":\"poll-end\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"choices\":[{\"id\":\"c1\",\"title\":\"Yes\",\"votes\":20,\"channel_points_votes\":2,\"bits_votes\":18},{\"id\":\"c2\",\"title\":\"No\",\"votes\":0,\"channel_points_votes\":0,\"bits_votes\":0}],\"status\":\"completed\",\"started_at\":\"2021-07-16T04:55:40Z\",\"ended_at\":\"2021-07-16T05:00:40Z\",\"bits_voting\":{\"is_enabled\":true,\"amount_per_vote\":10},\"channel_points_voting\":{\"is_enabled\":false,\"amount_per_vote\":0}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PredictionBeginEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PredictionBeginMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000004", msg.EventID)
+		require.Equal(t, EventPredictionBegin, msg.EventType)
+		require.Equal(t, "1243456", msg.EventData.ID)
+		require.Equal(t, "Will we win?", msg.EventData.Title)
+		require.Len(t, msg.EventData.Outcomes, 2)
+		require.Equal(t, "o1", msg.EventData.Outcomes[0].ID)
+		require.Equal(t, "blue", msg.EventData.Outcomes[0].Color)
+		require.Empty(t, msg.EventData.Outcomes[0].TopPredictors)
+		require.Equal(t, 5, msg.EventData.LocksAt.Hour())
+	}
+	client := Client{
+		predictionBeginCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000004\",\"event_type\":\"prediction-begin\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"outcomes\":[{\"id\":\"o1\",\"title\":\"Win\",\"color\":\"blue\",\"users\":0,\"channel_points\":0,\"top_predictors\":null},{\"id\":\"o2\",\"title\":\"Lose\",\"color\":\"pink\",\"users\":0,\"channel_points\":0,\"top_predictors\":null}],\"started_at\":\"2021-07-16T04:55:40Z\",\"locks_at\":\"2021-07-16T05:00:40Z\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PredictionProgressEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PredictionProgressMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000005", msg.EventID)
+		require.Equal(t, EventPredictionProgress, msg.EventType)
+		require.Equal(t, 1, msg.EventData.Outcomes[0].Users)
+		require.Equal(t, 500, msg.EventData.Outcomes[0].ChannelPoints)
+		require.Len(t, msg.EventData.Outcomes[0].TopPredictors, 1)
+		require.Equal(t, "536397236", msg.EventData.Outcomes[0].TopPredictors[0].UserID)
+		require.Equal(t, "FiniteSingularity", msg.EventData.Outcomes[0].TopPredictors[0].UserName)
+		require.Equal(t, 500, msg.EventData.Outcomes[0].TopPredictors[0].ChannelPointsUsed)
+	}
+	client := Client{
+		predictionProgressCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000005\",\"event_type\":\"prediction-progress\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"outcomes\":[{\"id\":\"o1\",\"title\":\"Win\",\"color\":\"blue\",\"users\":1,\"channel_points\":500,\"top_predictors\":[{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"channel_points_used\":500,\"channel_points_won\":0}]},{\"id\":\"o2\",\"title\":\"Lose\",\"color\":\"pink\",\"users\":0,\"channel_points\":0,\"top_predictors\":null}],\"started_at\":\"2021-07-16T04:55:40Z\",\"locks_at\":\"2021-07-16T05:00:40Z\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PredictionLockEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PredictionLockMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000006", msg.EventID)
+		require.Equal(t, EventPredictionLock, msg.EventType)
+		require.Equal(t, "1243456", msg.EventData.ID)
+		require.Equal(t, 1, msg.EventData.Outcomes[0].Users)
+		require.Equal(t, 5, msg.EventData.LockedAt.Hour())
+	}
+	client := Client{
+		predictionLockCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000006\",\"event_type\":\"prediction-lock\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"outcomes\":[{\"id\":\"o1\",\"title\":\"Win\",\"color\":\"blue\",\"users\":1,\"channel_points\":500,\"top_predictors\":[{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"channel_points_used\":500,\"channel_points_won\":0}]},{\"id\":\"o2\",\"title\":\"Lose\",\"color\":\"pink\",\"users\":0,\"channel_points\":0,\"top_predictors\":null}],\"started_at\":\"2021-07-16T04:55:40Z\",\"locked_at\":\"2021-07-16T05:00:40Z\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_PredictionEndEvent(t *testing.T) {
+	called := false
+	callback := func(msg *PredictionEndMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "c5d3e4f6-0000-4a5b-9c8d-000000000007", msg.EventID)
+		require.Equal(t, EventPredictionEnd, msg.EventType)
+		require.Equal(t, "o1", msg.EventData.WinningOutcomeID)
+		require.Equal(t, PredictionResolved, msg.EventData.Status)
+		require.Equal(t, 1000, msg.EventData.Outcomes[0].TopPredictors[0].ChannelPointsWon)
+		require.Equal(t, 5, msg.EventData.EndedAt.Hour())
+	}
+	client := Client{
+		predictionEndCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"c5d3e4f6-0000-4a5b-9c8d-000000000007\",\"event_type\":\"prediction-end\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"1243456\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"title\":\"Will we win?\",\"winning_outcome_id\":\"o1\",\"outcomes\":[{\"id\":\"o1\",\"title\":\"Win\",\"color\":\"blue\",\"users\":1,\"channel_points\":500,\"top_predictors\":[{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"channel_points_used\":500,\"channel_points_won\":1000}]},{\"id\":\"o2\",\"title\":\"Lose\",\"color\":\"pink\",\"users\":0,\"channel_points\":0,\"top_predictors\":null}],\"status\":\"resolved\",\"started_at\":\"2021-07-16T04:55:40Z\",\"ended_at\":\"2021-07-16T05:00:40Z\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_BanEvent(t *testing.T) {
+	called := false
+	callback := func(msg *BanMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "d6e4f5a7-0000-4b6c-8d9e-000000000001", msg.EventID)
+		require.Equal(t, EventBan, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "FiniteSingularity", msg.EventData.UserName)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, "12345", msg.EventData.ModeratorID)
+		require.Equal(t, "modperson", msg.EventData.ModeratorLogin)
+		require.Equal(t, "ModPerson", msg.EventData.ModeratorName)
+		require.Equal(t, "spam", msg.EventData.Reason)
+		require.Equal(t, 2021, msg.EventData.BannedAt.Year())
+		require.NotNil(t, msg.EventData.EndsAt)
+		require.Equal(t, 5, msg.EventData.EndsAt.Hour())
+		require.False(t, msg.EventData.IsPermanent)
+	}
+	client := Client{
+		banCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"d6e4f5a7-0000-4b6c-8d9e-000000000001\",\"event_type\":\"ban\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"moderator_user_id\":\"12345\",\"moderator_user_login\":\"modperson\",\"moderator_user_name\":\"ModPerson\",\"reason\":\"spam\",\"banned_at\":\"2021-07-16T04:55:40Z\",\"ends_at\":\"2021-07-16T05:05:40Z\",\"is_permanent\":false},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_UnbanEvent(t *testing.T) {
+	called := false
+	callback := func(msg *UnbanMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "d6e4f5a7-0000-4b6c-8d9e-000000000002", msg.EventID)
+		require.Equal(t, EventUnban, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterLogin)
+		require.Equal(t, "12345", msg.EventData.ModeratorID)
+	}
+	client := Client{
+		unbanCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"d6e4f5a7-0000-4b6c-8d9e-000000000002\",\"event_type\":\"unban\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"moderator_user_id\":\"12345\",\"moderator_user_login\":\"modperson\",\"moderator_user_name\":\"ModPerson\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_ModeratorAddEvent(t *testing.T) {
+	called := false
+	callback := func(msg *ModeratorAddMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "d6e4f5a7-0000-4b6c-8d9e-000000000003", msg.EventID)
+		require.Equal(t, EventModeratorAdd, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "finitesingularity", msg.EventData.UserLogin)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+	}
+	client := Client{
+		moderatorAddCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"d6e4f5a7-0000-4b6c-8d9e-000000000003\",\"event_type\":\"moderator-add\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_ModeratorRemoveEvent(t *testing.T) {
+	called := false
+	callback := func(msg *ModeratorRemoveMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "d6e4f5a7-0000-4b6c-8d9e-000000000004", msg.EventID)
+		require.Equal(t, EventModeratorRemove, msg.EventType)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "wwsean08", msg.EventData.BroadcasterName)
+	}
+	client := Client{
+		moderatorRemoveCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"d6e4f5a7-0000-4b6c-8d9e-000000000004\",\"event_type\":\"moderator-remove\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_BanEventPermanent(t *testing.T) {
+	called := false
+	callback := func(msg *BanMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.True(t, msg.EventData.IsPermanent)
+		require.Nil(t, msg.EventData.EndsAt)
+	}
+	client := Client{
+		banCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"d6e4f5a7-0000-4b6c-8d9e-000000000005\",\"event_type\":\"ban\",\"event_source\":\"TestCall\",\"event_data\":{\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"moderator_user_id\":\"12345\",\"moderator_user_login\":\"modperson\",\"moderator_user_name\":\"ModPerson\",\"reason\":\"\",\"banned_at\":\"2021-07-16T04:55:40Z\",\"ends_at\":null,\"is_permanent\":true},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_GoalBeginEvent(t *testing.T) {
+	called := false
+	callback := func(msg *GoalBeginMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "e7f5a6b8-0000-4c7d-9e0f-000000000001", msg.EventID)
+		require.Equal(t, EventGoalBegin, msg.EventType)
+		require.Equal(t, "12345-cool-event", msg.EventData.ID)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, GoalFollow, msg.EventData.Type)
+		require.Equal(t, "Follower goal", msg.EventData.Description)
+		require.Equal(t, 900, msg.EventData.CurrentAmount)
+		require.Equal(t, 1000, msg.EventData.TargetAmount)
+		require.Equal(t, 2021, msg.EventData.StartedAt.Year())
+	}
+	client := Client{
+		goalBeginCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"e7f5a6b8-0000-4c7d-9e0f-000000000001\",\"event_type\":\"goal-begin\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"12345-cool-event\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"type\":\"follow\",\"description\":\"Follower goal\",\"target_amount\":1000,\"started_at\":\"2021-07-16T04:55:40Z\",\"current_amount\":900},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_GoalProgressEvent(t *testing.T) {
+	called := false
+	callback := func(msg *GoalProgressMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "e7f5a6b8-0000-4c7d-9e0f-000000000002", msg.EventID)
+		require.Equal(t, EventGoalProgress, msg.EventType)
+		require.Equal(t, 950, msg.EventData.CurrentAmount)
+		require.Equal(t, 1000, msg.EventData.TargetAmount)
+	}
+	client := Client{
+		goalProgressCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"e7f5a6b8-0000-4c7d-9e0f-000000000002\",\"event_type\":\"goal-progress\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"12345-cool-event\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"type\":\"follow\",\"description\":\"Follower goal\",\"target_amount\":1000,\"started_at\":\"2021-07-16T04:55:40Z\",\"current_amount\":950},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_GoalEndEvent(t *testing.T) {
+	called := false
+	callback := func(msg *GoalEndMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "e7f5a6b8-0000-4c7d-9e0f-000000000003", msg.EventID)
+		require.Equal(t, EventGoalEnd, msg.EventType)
+		require.True(t, msg.EventData.IsAchieved)
+		require.Equal(t, 1000, msg.EventData.CurrentAmount)
+		require.Equal(t, 17, msg.EventData.EndedAt.Day())
+	}
+	client := Client{
+		goalEndCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"e7f5a6b8-0000-4c7d-9e0f-000000000003\",\"event_type\":\"goal-end\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"12345-cool-event\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_login\":\"wwsean08\",\"broadcaster_user_name\":\"wwsean08\",\"type\":\"follow\",\"description\":\"Follower goal\",\"target_amount\":1000,\"started_at\":\"2021-07-16T04:55:40Z\",\"current_amount\":1000,\"is_achieved\":true,\"ended_at\":\"2021-07-17T04:55:40Z\"},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_CharityCampaignDonateEvent(t *testing.T) {
+	called := false
+	callback := func(msg *CharityCampaignDonateMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "e7f5a6b8-0000-4c7d-9e0f-000000000004", msg.EventID)
+		require.Equal(t, EventCharityCampaignDonate, msg.EventType)
+		require.Equal(t, "123-abc-456-def", msg.EventData.CampaignID)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, "wwsean08", msg.Broadcaster().BroadcasterLogin)
+		require.Equal(t, "536397236", msg.EventData.UserID)
+		require.Equal(t, "FiniteSingularity", msg.EventData.UserName)
+		require.Equal(t, "Example name", msg.EventData.CharityName)
+		require.Equal(t, "https://www.example.com", msg.EventData.CharityWebsite)
+		require.Equal(t, 10000, msg.EventData.Amount.Value)
+		require.Equal(t, 2, msg.EventData.Amount.DecimalPlaces)
+		require.Equal(t, "USD", msg.EventData.Amount.Currency)
+	}
+	client := Client{
+		charityCampaignDonateCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"e7f5a6b8-0000-4c7d-9e0f-000000000004\",\"event_type\":\"charity-campaign-donate\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"12345-cool-event\",\"broadcaster_id\":\"47073625\",\"broadcaster_login\":\"wwsean08\",\"broadcaster_name\":\"wwsean08\",\"charity_name\":\"Example name\",\"charity_description\":\"Example description\",\"charity_logo\":\"https://abc.cloudfront.net/ppgf/1000/100.png\",\"charity_website\":\"https://www.example.com\",\"campaign_id\":\"123-abc-456-def\",\"user_id\":\"536397236\",\"user_login\":\"finitesingularity\",\"user_name\":\"FiniteSingularity\",\"amount\":{\"value\":10000,\"decimal_places\":2,\"currency\":\"USD\"}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
+
+func TestHandleMessage_CharityCampaignProgressEvent(t *testing.T) {
+	called := false
+	callback := func(msg *CharityCampaignProgressMsg) {
+		called = true
+		require.NotNil(t, msg)
+		require.Equal(t, "e7f5a6b8-0000-4c7d-9e0f-000000000005", msg.EventID)
+		require.Equal(t, EventCharityCampaignProgress, msg.EventType)
+		require.Equal(t, "47073625", msg.EventData.BroadcasterID)
+		require.Equal(t, "wwsean08", msg.Broadcaster().BroadcasterName)
+		require.Equal(t, "Example description", msg.EventData.CharityDescription)
+		require.Equal(t, 260000, msg.EventData.CurrentAmount.Value)
+		require.Equal(t, 1500000, msg.EventData.TargetAmount.Value)
+		require.Equal(t, "USD", msg.EventData.TargetAmount.Currency)
+	}
+	client := Client{
+		charityCampaignProgressCallback: callback,
+	}
+	msg := "{\"id\":null,\"event_id\":\"e7f5a6b8-0000-4c7d-9e0f-000000000005\",\"event_type\":\"charity-campaign-progress\",\"event_source\":\"TestCall\",\"event_data\":{\"id\":\"12345-cool-event\",\"broadcaster_id\":\"47073625\",\"broadcaster_login\":\"wwsean08\",\"broadcaster_name\":\"wwsean08\",\"charity_name\":\"Example name\",\"charity_description\":\"Example description\",\"charity_logo\":\"https://abc.cloudfront.net/ppgf/1000/100.png\",\"charity_website\":\"https://www.example.com\",\"current_amount\":{\"value\":260000,\"decimal_places\":2,\"currency\":\"USD\"},\"target_amount\":{\"value\":1500000,\"decimal_places\":2,\"currency\":\"USD\"}},\"created\":\"2021-07-16T04:55:40.231592+00:00\",\"origin\":\"test\"}"
+	client.handleMessage([]byte(msg))
+	require.True(t, called)
+}
@@ -0,0 +1,102 @@
+package gotau
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff describes an exponential backoff with optional jitter.  The wait before attempt n is
+// InitialInterval * Multiplier^(n-1), capped at MaxInterval, and then randomized by up to +/- Jitter of itself.
+type Backoff struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter is the fraction (0 to 1) of the computed interval that is randomly added or subtracted.
+	Jitter float64
+}
+
+// Duration returns how long to wait before the given attempt, the first attempt being 1.
+func (b Backoff) Duration(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	interval := float64(b.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if b.MaxInterval > 0 && interval > float64(b.MaxInterval) {
+		interval = float64(b.MaxInterval)
+	}
+	if b.Jitter > 0 {
+		jitter := math.Min(b.Jitter, 1)
+		/* #nosec G404 */
+		interval += interval * jitter * (2*rand.Float64() - 1)
+	}
+	if interval < 0 {
+		return 0
+	}
+	return time.Duration(interval)
+}
+
+// ReconnectPolicy controls how the client automatically reconnects to TAU when the websocket drops.
+type ReconnectPolicy struct {
+	Backoff
+	// MaxAttempts is the number of attempts made before giving up and passing the last error to the ErrorCallback.
+	// A value of 0 or less means keep trying forever.
+	MaxAttempts int
+}
+
+// DefaultReconnectPolicy returns a policy that retries forever, starting at 1 second between attempts and backing
+// off to at most 1 minute.
+func DefaultReconnectPolicy() *ReconnectPolicy {
+	return &ReconnectPolicy{
+		Backoff: Backoff{
+			InitialInterval: time.Second,
+			MaxInterval:     time.Minute,
+			Multiplier:      2,
+			Jitter:          0.2,
+		},
+	}
+}
+
+// SetAutoReconnect enables automatically reconnecting (and logging back in) when the websocket connection is lost,
+// using the supplied policy.  Passing nil disables it, which is the default, in which case connection errors are
+// passed to the ErrorCallback.
+func (c *Client) SetAutoReconnect(policy *ReconnectPolicy) {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	c.reconnectPolicy = policy
+}
+
+// superviseReconnect keeps trying to reconnect based on the policy.  The generation is the one that was active when
+// the connection dropped, if it changes someone else (e.g. a manual Reconnect) already fixed the connection.
+func (c *Client) superviseReconnect(policy *ReconnectPolicy, generation uint64) {
+	c.callbackLock.RLock()
+	reconnectCallback := c.reconnectCallback
+	c.callbackLock.RUnlock()
+	var err error
+	for attempt := 1; policy.MaxAttempts <= 0 || attempt <= policy.MaxAttempts; attempt++ {
+		delay := policy.Duration(attempt)
+		if reconnectCallback != nil {
+			c.safeCall(nil, func() {
+				reconnectCallback(attempt, delay)
+			})
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-c.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		err = c.reconnect(true, generation)
+		if err == nil || c.Err() != nil {
+			return
+		}
+	}
+	c.logf("giving up reconnecting to TAU after %d attempts: %v", policy.MaxAttempts, err)
+	c.handleError(err)
+}
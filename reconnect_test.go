@@ -0,0 +1,254 @@
+package gotau
+
+import (
+	"encoding/json"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBackoff_Duration(t *testing.T) {
+	backoff := Backoff{
+		InitialInterval: time.Second,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+	}
+	require.Equal(t, time.Second, backoff.Duration(0))
+	require.Equal(t, time.Second, backoff.Duration(1))
+	require.Equal(t, 2*time.Second, backoff.Duration(2))
+	require.Equal(t, 4*time.Second, backoff.Duration(3))
+	require.Equal(t, 8*time.Second, backoff.Duration(4))
+	require.Equal(t, 10*time.Second, backoff.Duration(5))
+	require.Equal(t, 10*time.Second, backoff.Duration(100))
+}
+
+func TestBackoff_DurationWithJitter(t *testing.T) {
+	backoff := Backoff{
+		InitialInterval: time.Second,
+		MaxInterval:     time.Minute,
+		Multiplier:      2,
+		Jitter:          0.5,
+	}
+	for i := 0; i < 100; i++ {
+		duration := backoff.Duration(2)
+		require.True(t, duration >= time.Second, duration)
+		require.True(t, duration <= 3*time.Second, duration)
+	}
+}
+
+func TestClient_SetAutoReconnect(t *testing.T) {
+	client := Client{}
+	require.Nil(t, client.reconnectPolicy)
+	policy := DefaultReconnectPolicy()
+	client.SetAutoReconnect(policy)
+	require.Equal(t, policy, client.reconnectPolicy)
+	client.SetAutoReconnect(nil)
+	require.Nil(t, client.reconnectPolicy)
+}
+
+func TestClient_AutoReconnect(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	logins := make(chan string, 10)
+	connections := 0
+	connectionLock := new(sync.Mutex)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		type token struct {
+			Token string `json:"token"`
+		}
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close()
+		connectionLock.Lock()
+		connections++
+		first := connections == 1
+		connectionLock.Unlock()
+
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			return
+		}
+		myToken := new(token)
+		err = json.Unmarshal(message, myToken)
+		require.NoError(t, err)
+		logins <- myToken.Token
+		if first {
+			// drop the first connection right after login to trigger the reconnect
+			return
+		}
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	disconnected := make(chan error, 1)
+	reconnecting := make(chan int, 10)
+	connected := make(chan struct{}, 2)
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+		errorCallback: func(err error) {
+			require.Fail(t, "error callback should not be called", err)
+		},
+		disconnectCallback: func(err error) {
+			disconnected <- err
+		},
+		reconnectCallback: func(attempt int, delay time.Duration) {
+			reconnecting <- attempt
+		},
+		connectCallback: func() {
+			connected <- struct{}{}
+		},
+	}
+	client.SetAutoReconnect(&ReconnectPolicy{
+		Backoff: Backoff{
+			InitialInterval: time.Millisecond,
+			MaxInterval:     time.Millisecond,
+		},
+	})
+	err = client.Reconnect()
+	require.NoError(t, err)
+	require.Equal(t, "foo", <-logins)
+	<-connected
+
+	select {
+	case err := <-disconnected:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for disconnect")
+	}
+	require.Equal(t, 1, <-reconnecting)
+	require.Equal(t, "foo", <-logins)
+	select {
+	case <-connected:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for reconnect")
+	}
+	require.NotNil(t, client.currentConn())
+}
+
+func TestClient_AutoReconnectGivesUp(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	connections := 0
+	connectionLock := new(sync.Mutex)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		connectionLock.Lock()
+		connections++
+		first := connections == 1
+		connectionLock.Unlock()
+		if !first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		c, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		_, _, _ = c.ReadMessage()
+		_ = c.Close()
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	errs := make(chan error, 1)
+	attempts := 0
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+		errorCallback: func(err error) {
+			errs <- err
+		},
+		reconnectCallback: func(attempt int, delay time.Duration) {
+			attempts = attempt
+		},
+	}
+	client.SetAutoReconnect(&ReconnectPolicy{
+		Backoff: Backoff{
+			InitialInterval: time.Millisecond,
+		},
+		MaxAttempts: 3,
+	})
+	err = client.Reconnect()
+	require.NoError(t, err)
+
+	select {
+	case err := <-errs:
+		require.Error(t, err)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for reconnect to give up")
+	}
+	require.Equal(t, 3, attempts)
+	require.Nil(t, client.currentConn())
+}
+
+func TestClient_SuperviseReconnectStopsWhenClosed(t *testing.T) {
+	client := &Client{writeLock: new(sync.Mutex)}
+	waiting := make(chan struct{})
+	client.reconnectCallback = func(attempt int, delay time.Duration) {
+		close(waiting)
+	}
+
+	returned := make(chan struct{})
+	go func() {
+		client.superviseReconnect(&ReconnectPolicy{Backoff: Backoff{InitialInterval: time.Hour}}, 0)
+		close(returned)
+	}()
+	<-waiting
+	require.NoError(t, client.Close())
+
+	select {
+	case <-returned:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the reconnect to stop")
+	}
+}
+
+func TestClient_SuperviseReconnectKeepsNewerConnection(t *testing.T) {
+	closeCodes := make(chan int, 1)
+	server, host, portNum := newCloseTestServer(t, "", closeCodes)
+	defer server.Close()
+
+	client := &Client{
+		hostname:  host,
+		port:      portNum,
+		token:     "foo",
+		writeLock: new(sync.Mutex),
+	}
+	err := client.Reconnect()
+	require.NoError(t, err)
+	conn := client.currentConn()
+
+	// a supervisor for the connection that dropped before the manual Reconnect above must leave it alone
+	err = client.reconnect(true, 0)
+	require.NoError(t, err)
+	require.Equal(t, conn, client.currentConn())
+	client.stateLock.RLock()
+	require.Equal(t, uint64(1), client.generation)
+	client.stateLock.RUnlock()
+
+	require.NoError(t, client.Close())
+	require.Equal(t, websocket.CloseNormalClosure, <-closeCodes)
+}
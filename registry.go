@@ -0,0 +1,136 @@
+package gotau
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// EventFactory returns a new, empty message for an event type to be decoded into.  It must return a pointer to a
+// struct which embeds *Event, like the built in message types.
+type EventFactory func() TAUEvent
+
+var registry = struct {
+	sync.RWMutex
+	factories map[EventType]EventFactory
+}{
+	factories: make(map[EventType]EventFactory),
+}
+
+// builtinEventTypes are the event types this library decodes itself, it is filled in by init once they have been
+// registered so that they can't be replaced afterwards.
+var builtinEventTypes map[EventType]bool
+
+func init() {
+	builtin := map[EventType]EventFactory{
+		EventFollow:                  func() TAUEvent { return new(FollowMsg) },
+		EventStreamUpdate:            func() TAUEvent { return new(StreamUpdateMsg) },
+		EventCheer:                   func() TAUEvent { return new(CheerMsg) },
+		EventRaid:                    func() TAUEvent { return new(RaidMsg) },
+		EventSubscription:            func() TAUEvent { return new(SubscriptionMsg) },
+		EventPointsRedemption:        func() TAUEvent { return new(PointsRedemptionMsg) },
+		EventHypeTrainBegin:          func() TAUEvent { return new(HypeTrainBeginMsg) },
+		EventHypeTrainProgress:       func() TAUEvent { return new(HypeTrainProgressMsg) },
+		EventHypeTrainEnd:            func() TAUEvent { return new(HypeTrainEndedMsg) },
+		EventStreamOnline:            func() TAUEvent { return new(StreamOnlineMsg) },
+		EventStreamOffline:           func() TAUEvent { return new(StreamOfflineMsg) },
+		EventChannelSubscribe:        func() TAUEvent { return new(ChannelSubscribeMsg) },
+		EventSubscriptionEnd:         func() TAUEvent { return new(SubscriptionEndMsg) },
+		EventSubscriptionGift:        func() TAUEvent { return new(SubscriptionGiftMsg) },
+		EventSubscriptionMessage:     func() TAUEvent { return new(SubscriptionMessageMsg) },
+		EventPointsRewardAdd:         func() TAUEvent { return new(PointsRewardAddMsg) },
+		EventPointsRewardUpdate:      func() TAUEvent { return new(PointsRewardUpdateMsg) },
+		EventPointsRewardRemove:      func() TAUEvent { return new(PointsRewardRemoveMsg) },
+		EventPointsRedemptionUpdate:  func() TAUEvent { return new(PointsRedemptionUpdateMsg) },
+		EventPollBegin:               func() TAUEvent { return new(PollBeginMsg) },
+		EventPollProgress:            func() TAUEvent { return new(PollProgressMsg) },
+		EventPollEnd:                 func() TAUEvent { return new(PollEndMsg) },
+		EventPredictionBegin:         func() TAUEvent { return new(PredictionBeginMsg) },
+		EventPredictionProgress:      func() TAUEvent { return new(PredictionProgressMsg) },
+		EventPredictionLock:          func() TAUEvent { return new(PredictionLockMsg) },
+		EventPredictionEnd:           func() TAUEvent { return new(PredictionEndMsg) },
+		EventBan:                     func() TAUEvent { return new(BanMsg) },
+		EventUnban:                   func() TAUEvent { return new(UnbanMsg) },
+		EventModeratorAdd:            func() TAUEvent { return new(ModeratorAddMsg) },
+		EventModeratorRemove:         func() TAUEvent { return new(ModeratorRemoveMsg) },
+		EventGoalBegin:               func() TAUEvent { return new(GoalBeginMsg) },
+		EventGoalProgress:            func() TAUEvent { return new(GoalProgressMsg) },
+		EventGoalEnd:                 func() TAUEvent { return new(GoalEndMsg) },
+		EventCharityCampaignDonate:   func() TAUEvent { return new(CharityCampaignDonateMsg) },
+		EventCharityCampaignProgress: func() TAUEvent { return new(CharityCampaignProgressMsg) },
+	}
+	for eventType, factory := range builtin {
+		if err := RegisterEventType(eventType, factory); err != nil {
+			panic(err)
+		}
+	}
+	builtinEventTypes = make(map[EventType]bool, len(builtin))
+	for eventType := range builtin {
+		builtinEventTypes[eventType] = true
+	}
+}
+
+// RegisterEventType teaches every client how to decode events with the given event type, for events this library
+// doesn't know about such as ones from a TAU fork.  Decoded events are sent to the event channels and any handlers
+// registered with On, for example:
+//
+//	type MyEventMsg struct {
+//		*gotau.Event
+//		EventData struct {
+//			Foo string `json:"foo"`
+//		} `json:"event_data"`
+//	}
+//
+//	err := gotau.RegisterEventType("my-event", func() gotau.TAUEvent { return new(MyEventMsg) })
+//	client.On("my-event", func(event gotau.TAUEvent) {
+//		msg := event.(*MyEventMsg)
+//	})
+//
+// Registering an event type that has already been registered replaces its factory.  The built in event types can't be
+// replaced, as their callbacks and typed On... handlers rely on their message types, so ErrBuiltinEventType is
+// returned for them.
+func RegisterEventType(eventType EventType, factory EventFactory) error {
+	if strings.TrimSpace(string(eventType)) == "" {
+		return ErrBlankEventType
+	}
+	if factory == nil {
+		return ErrNilEventFactory
+	}
+	if builtinEventTypes[eventType] {
+		return ErrBuiltinEventType
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.factories[eventType] = factory
+	return nil
+}
+
+// RegisteredEventTypes returns the event types that can be decoded, in no particular order.
+func RegisteredEventTypes() []EventType {
+	registry.RLock()
+	defer registry.RUnlock()
+	eventTypes := make([]EventType, 0, len(registry.factories))
+	for eventType := range registry.factories {
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes
+}
+
+// decodeEvent parses msg into the message type registered for eventType, returning nil if the event type isn't
+// registered.
+func decodeEvent(eventType EventType, msg []byte) (TAUEvent, error) {
+	registry.RLock()
+	factory, ok := registry.factories[eventType]
+	registry.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	tauEvent := factory()
+	err := json.Unmarshal(msg, tauEvent)
+	if err != nil {
+		return nil, err
+	}
+	return tauEvent, nil
+}
@@ -0,0 +1,60 @@
+package gotau
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testCustomMsg struct {
+	*Event
+	EventData struct {
+		Foo string `json:"foo"`
+	} `json:"event_data"`
+}
+
+func TestRegisterEventType(t *testing.T) {
+	err := RegisterEventType("test-custom-event", func() TAUEvent {
+		return new(testCustomMsg)
+	})
+	require.NoError(t, err)
+	require.Contains(t, RegisteredEventTypes(), EventType("test-custom-event"))
+	require.Contains(t, RegisteredEventTypes(), EventFollow)
+
+	client := Client{}
+	unknownCalled := false
+	client.SetUnknownEventCallback(func(event *Event, msg []byte) {
+		unknownCalled = true
+	})
+	var received *testCustomMsg
+	client.On("test-custom-event", func(event TAUEvent) {
+		received = event.(*testCustomMsg)
+	})
+	events := client.Events(context.Background())
+
+	client.handleMessage([]byte("{\"event_id\":\"1234\",\"event_type\":\"test-custom-event\",\"event_data\":{\"foo\":\"bar\"}}"))
+	require.False(t, unknownCalled)
+	require.NotNil(t, received)
+	require.Equal(t, "1234", received.EventID)
+	require.Equal(t, "bar", received.EventData.Foo)
+	require.Equal(t, received, <-events)
+}
+
+func TestRegisterEventType_Invalid(t *testing.T) {
+	err := RegisterEventType(" ", func() TAUEvent {
+		return new(testCustomMsg)
+	})
+	require.Equal(t, ErrBlankEventType, err)
+
+	err = RegisterEventType("test-custom-event", nil)
+	require.Equal(t, ErrNilEventFactory, err)
+
+	err = RegisterEventType(EventFollow, func() TAUEvent {
+		return new(testCustomMsg)
+	})
+	require.Equal(t, ErrBuiltinEventType, err)
+	msg, err := decodeEvent(EventFollow, []byte(fmt.Sprintf(testFollowMsg, "FiniteSingularity")))
+	require.NoError(t, err)
+	require.IsType(t, &FollowMsg{}, msg)
+}
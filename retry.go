@@ -0,0 +1,125 @@
+package gotau
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how REST requests to TAU, and through it to twitch, are retried after a transient failure
+// such as a dropped connection or a 502/503/504 while TAU restarts.  It is used by this package and the helix package.
+type RetryPolicy struct {
+	Backoff
+	// MaxAttempts is the total number of attempts including the first, values below 2 disable retries.
+	MaxAttempts int
+	// StatusCodes are the response codes that are retried.
+	StatusCodes []int
+	// Methods are the http methods that are retried.  When empty only the idempotent methods GET, PUT and DELETE are
+	// retried, POST and PATCH have to be opted in explicitly as retrying them could repeat the change.
+	Methods []string
+	// RetryError decides whether an error that didn't come with a response is retried.  When nil only transient
+	// network errors are retried, i.e. timeouts, refused or reset connections and connections closed mid response.
+	RetryError func(err error) bool
+	// OnRetry is called before waiting to retry a failed attempt, the first attempt being 1.
+	OnRetry func(attempt int, err error, wait time.Duration)
+}
+
+// DefaultRetryPolicy returns a policy that makes up to 3 attempts at GET, PUT and DELETE requests that fail with a
+// transient network error or a 502, 503 or 504, waiting 500 milliseconds and then 1 second between them.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Backoff: Backoff{
+			InitialInterval: 500 * time.Millisecond,
+			MaxInterval:     5 * time.Second,
+			Multiplier:      2,
+			Jitter:          0.2,
+		},
+		MaxAttempts: 3,
+		StatusCodes: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		Methods:     []string{http.MethodGet, http.MethodPut, http.MethodDelete},
+	}
+}
+
+// Do calls attempt until it succeeds, fails in a way the policy doesn't retry, or runs out of attempts, waiting
+// between attempts according to the Backoff.  The last result is returned.  A nil policy calls attempt once.
+func (p *RetryPolicy) Do(ctx context.Context, method string, attempt func() ([]byte, error)) ([]byte, error) {
+	for attempts := 1; ; attempts++ {
+		body, err := attempt()
+		if err == nil || p == nil || attempts >= p.MaxAttempts || !p.retryable(ctx, method, err) {
+			return body, err
+		}
+		wait := p.Duration(attempts)
+		if p.OnRetry != nil {
+			p.OnRetry(attempts, err, wait)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p *RetryPolicy) retryable(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil || !p.retriesMethod(method) {
+		return false
+	}
+	var generic GenericError
+	if errors.As(err, &generic) {
+		for _, code := range p.StatusCodes {
+			if generic.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+	if p.RetryError != nil {
+		return p.RetryError(err)
+	}
+	return transient(err)
+}
+
+// transient reports whether err is a network error worth retrying.  Every error from http.Client.Do is a net.Error, so
+// that alone doesn't say anything, e.g. a bad url or certificate would fail the same way every time.
+func transient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+func (p *RetryPolicy) retriesMethod(method string) bool {
+	methods := p.Methods
+	if len(methods) == 0 {
+		methods = []string{http.MethodGet, http.MethodPut, http.MethodDelete}
+	}
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetRetryPolicy enables retrying REST requests that fail with a transient error using the supplied policy, passing
+// nil disables it, which is the default.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	c.retryPolicy = policy
+}
+
+func (c *Client) getRetryPolicy() *RetryPolicy {
+	c.stateLock.RLock()
+	defer c.stateLock.RUnlock()
+	return c.retryPolicy
+}
@@ -0,0 +1,262 @@
+package gotau
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fastRetryPolicy is the default policy without the waiting.
+func fastRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	policy.MaxInterval = time.Millisecond
+	return policy
+}
+
+// newFlakyServer responds with the given status codes in turn, and 200 once they run out.
+func newFlakyServer(t *testing.T, codes ...int) (*httptest.Server, *int) {
+	lock := sync.Mutex{}
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		request := requests
+		requests++
+		lock.Unlock()
+		if request < len(codes) {
+			w.WriteHeader(codes[request])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"token": "baz"}`))
+		require.NoError(t, err)
+	}))
+	return ts, &requests
+}
+
+func TestRetryPolicy_Do(t *testing.T) {
+	policy := fastRetryPolicy()
+	retries := make([]int, 0)
+	policy.OnRetry = func(attempt int, err error, wait time.Duration) {
+		retries = append(retries, attempt)
+		require.Error(t, err)
+	}
+
+	attempts := 0
+	body, err := policy.Do(context.Background(), http.MethodGet, func() ([]byte, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, GenericError{Code: http.StatusServiceUnavailable}
+		}
+		return []byte("ok"), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(body))
+	require.Equal(t, []int{1, 2}, retries)
+
+	attempts = 0
+	_, err = policy.Do(context.Background(), http.MethodGet, func() ([]byte, error) {
+		attempts++
+		return nil, GenericError{Code: http.StatusBadGateway}
+	})
+	require.Equal(t, GenericError{Code: http.StatusBadGateway}, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestRetryPolicy_DoDoesNotRetry(t *testing.T) {
+	policy := fastRetryPolicy()
+	tests := []struct {
+		name   string
+		method string
+		err    error
+	}{
+		{"status", http.MethodGet, GenericError{Code: http.StatusInternalServerError}},
+		{"unauthorized", http.MethodGet, AuthorizationError{}},
+		{"other error", http.MethodGet, errors.New("boom")},
+		{"post", http.MethodPost, GenericError{Code: http.StatusServiceUnavailable}},
+		{"patch", http.MethodPatch, &net.OpError{Op: "read", Err: syscall.ECONNRESET}},
+		{"bad url", http.MethodGet, &url.Error{Op: "Get", URL: "foo://bar", Err: errors.New("unsupported protocol scheme")}},
+		{"permanent network error", http.MethodGet, &net.OpError{Op: "dial", Err: errors.New("no such host")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			_, err := policy.Do(context.Background(), tt.method, func() ([]byte, error) {
+				attempts++
+				return nil, tt.err
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, 1, attempts)
+		})
+	}
+
+	var nilPolicy *RetryPolicy
+	attempts := 0
+	_, err := nilPolicy.Do(context.Background(), http.MethodGet, func() ([]byte, error) {
+		attempts++
+		return nil, GenericError{Code: http.StatusServiceUnavailable}
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
+
+// timeoutError is a net.Error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryPolicy_DoRetriesTransientErrors(t *testing.T) {
+	policy := fastRetryPolicy()
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"timeout", &url.Error{Op: "Get", URL: "http://foo", Err: timeoutError{}}},
+		{"refused", &url.Error{Op: "Get", URL: "http://foo", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}},
+		{"reset", &url.Error{Op: "Get", URL: "http://foo", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}},
+		{"unexpected eof", fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF)},
+		{"eof", &url.Error{Op: "Get", URL: "http://foo", Err: io.EOF}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			_, err := policy.Do(context.Background(), http.MethodGet, func() ([]byte, error) {
+				attempts++
+				return nil, tt.err
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, 3, attempts)
+		})
+	}
+}
+
+func TestRetryPolicy_DoOptIn(t *testing.T) {
+	policy := fastRetryPolicy()
+	policy.Methods = append(policy.Methods, http.MethodPost)
+	policy.RetryError = func(err error) bool {
+		return err.Error() == "try again"
+	}
+
+	attempts := 0
+	_, err := policy.Do(context.Background(), "post", func() ([]byte, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("try again")
+		}
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+
+	policy.Methods = nil
+	policy.RetryError = nil
+	for _, method := range []string{http.MethodDelete, http.MethodPost} {
+		attempts = 0
+		_, err = policy.Do(context.Background(), method, func() ([]byte, error) {
+			attempts++
+			if attempts == 1 {
+				return nil, &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}
+			}
+			return nil, nil
+		})
+		if method == http.MethodPost {
+			require.Error(t, err)
+			require.Equal(t, 1, attempts)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, 2, attempts)
+		}
+	}
+}
+
+func TestRetryPolicy_DoContextCanceled(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.InitialInterval = time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	policy.OnRetry = func(attempt int, err error, wait time.Duration) {
+		cancel()
+	}
+
+	attempts := 0
+	_, err := policy.Do(ctx, http.MethodGet, func() ([]byte, error) {
+		attempts++
+		return nil, GenericError{Code: http.StatusGatewayTimeout}
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, attempts)
+}
+
+func TestClient_SetRetryPolicy(t *testing.T) {
+	ts, requests := newFlakyServer(t, http.StatusServiceUnavailable, http.StatusBadGateway)
+	defer ts.Close()
+	client := newStreamPagesClient(t, ts)
+
+	_, err := client.apiRequest(context.Background(), "streamers", nil, nil, http.MethodGet)
+	require.Error(t, err)
+	require.Equal(t, 1, *requests)
+
+	client.SetRetryPolicy(fastRetryPolicy())
+	body, err := client.apiRequest(context.Background(), "streamers", nil, nil, http.MethodGet)
+	require.NoError(t, err)
+	require.Equal(t, `{"token": "baz"}`, string(body))
+	require.Equal(t, 3, *requests)
+}
+
+func TestClient_SetRetryPolicyNetworkError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	client := &Client{hostname: host, port: portNum, token: "foo"}
+	retries := 0
+	policy := fastRetryPolicy()
+	policy.OnRetry = func(attempt int, err error, wait time.Duration) {
+		retries++
+	}
+	client.SetRetryPolicy(policy)
+
+	_, err = client.apiRequest(context.Background(), "streamers", nil, nil, http.MethodGet)
+	require.Error(t, err)
+	require.Equal(t, 2, retries)
+}
+
+func TestGetAuthTokenWithOptions_RetryPolicy(t *testing.T) {
+	ts, requests := newFlakyServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	defer ts.Close()
+	url := strings.TrimPrefix(ts.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	_, err = GetAuthTokenWithOptions(context.Background(), "foo", "bar", WithHost(host, portNum),
+		WithRetryPolicy(fastRetryPolicy()))
+	require.Error(t, err)
+	require.Equal(t, 1, *requests)
+
+	policy := fastRetryPolicy()
+	policy.Methods = append(policy.Methods, http.MethodPost)
+	token, err := GetAuthTokenWithOptions(context.Background(), "foo", "bar", WithHost(host, portNum),
+		WithRetryPolicy(policy))
+	require.NoError(t, err)
+	require.Equal(t, "baz", token)
+	require.Equal(t, 3, *requests)
+}
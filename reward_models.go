@@ -0,0 +1,37 @@
+package gotau
+
+import "time"
+
+// CustomReward is the part of a channel points custom reward that is the same in the TAU websocket events and the
+// helix custom reward responses.
+type CustomReward struct {
+	ID                                string             `json:"id"`
+	Title                             string             `json:"title"`
+	Prompt                            string             `json:"prompt"`
+	Cost                              int                `json:"cost"`
+	Image                             *CustomRewardImage `json:"image"`
+	DefaultImage                      *CustomRewardImage `json:"default_image"`
+	BackgroundColor                   string             `json:"background_color"`
+	IsEnabled                         bool               `json:"is_enabled"`
+	IsUserInputRequired               bool               `json:"is_user_input_required"`
+	IsPaused                          bool               `json:"is_paused"`
+	IsInStock                         bool               `json:"is_in_stock"`
+	ShouldRedemptionsSkipRequestQueue bool               `json:"should_redemptions_skip_request_queue"`
+	RedemptionsRedeemedCurrentStream  int                `json:"redemptions_redeemed_current_stream"`
+	CooldownExpiresAt                 *time.Time         `json:"cooldown_expires_at"`
+}
+
+// CustomRewardImage represents the various images associated with a custom reward.
+type CustomRewardImage struct {
+	Url1X string `json:"url_1x"`
+	Url2X string `json:"url_2x"`
+	Url4X string `json:"url_4x"`
+}
+
+// RedemptionReward is the basic information about the reward that was redeemed, included with redemptions.
+type RedemptionReward struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Prompt string `json:"prompt"`
+	Cost   int    `json:"cost"`
+}
@@ -1,12 +1,14 @@
 package gotau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 import "github.com/gorilla/websocket"
 
@@ -19,72 +21,345 @@ type Client struct {
 	token              string
 	writeLock          *sync.Mutex
 	parallelProcessing bool
+	httpClient         *http.Client
+	basePath           string
+	dialer             *websocket.Dialer
+	header             http.Header
+	logger             Logger
 
-	// callback functions
-	rawCallback               RawCallback
-	errorCallback             ErrorCallback
-	streamOnlineCallback      StreamOnlineCallback
-	streamOfflineCallback     StreamOfflineCallback
-	followCallback            FollowCallback
-	streamUpdateCallback      StreamUpdateCallback
-	cheerCallback             CheerCallback
-	raidCallback              RaidCallback
-	subscriptionCallback      SubscriptionCallback
-	pointsRedemptionCallback  PointsRedemptionCallback
-	hypeTrainBeginCallback    HypeTrainBeginCallback
-	hypeTrainProgressCallback HypeTrainProgressCallback
-	hypeTrainEndedCallback    HypeTrainEndCallback
+	// connection state, used to make sure only one read loop is running at a time
+	stateLock       sync.RWMutex
+	connLock        sync.Mutex
+	loopDone        chan struct{}
+	generation      uint64
+	reconnectPolicy *ReconnectPolicy
+	keepalive       *KeepaliveConfig
+	retryPolicy     *RetryPolicy
+	// terminal state, once done is closed err holds why the client stopped
+	done chan struct{}
+	err  error
+	// handlers running in their own goroutine because of parallel processing or the worker pool
+	handlerWG sync.WaitGroup
+	poolLock  sync.RWMutex
+	pool      *workerPool
+
+	// event streams returned by Events
+	streamLock sync.RWMutex
+	streams    map[*eventStream]struct{}
+
+	// callback functions, guarded by callbackLock along with the handlers registered via the On... functions
+	callbackLock                    sync.RWMutex
+	handlers                        map[EventType][]eventHandler
+	nextHandlerID                   uint64
+	middleware                      []Middleware
+	chain                           Handler
+	rawCallback                     RawCallback
+	errorCallback                   ErrorCallback
+	panicCallback                   PanicCallback
+	unknownEventCallback            UnknownEventCallback
+	decodeErrorCallback             DecodeErrorCallback
+	connectCallback                 ConnectCallback
+	disconnectCallback              DisconnectCallback
+	reconnectCallback               ReconnectCallback
+	streamOnlineCallback            StreamOnlineCallback
+	streamOfflineCallback           StreamOfflineCallback
+	followCallback                  FollowCallback
+	streamUpdateCallback            StreamUpdateCallback
+	cheerCallback                   CheerCallback
+	raidCallback                    RaidCallback
+	subscriptionCallback            SubscriptionCallback
+	pointsRedemptionCallback        PointsRedemptionCallback
+	hypeTrainBeginCallback          HypeTrainBeginCallback
+	hypeTrainProgressCallback       HypeTrainProgressCallback
+	hypeTrainEndedCallback          HypeTrainEndCallback
+	channelSubscribeCallback        ChannelSubscribeCallback
+	subscriptionEndCallback         SubscriptionEndCallback
+	subscriptionGiftCallback        SubscriptionGiftCallback
+	subscriptionMessageCallback     SubscriptionMessageCallback
+	pointsRewardAddCallback         PointsRewardAddCallback
+	pointsRewardUpdateCallback      PointsRewardUpdateCallback
+	pointsRewardRemoveCallback      PointsRewardRemoveCallback
+	pointsRedemptionUpdateCallback  PointsRedemptionUpdateCallback
+	pollBeginCallback               PollBeginCallback
+	pollProgressCallback            PollProgressCallback
+	pollEndCallback                 PollEndCallback
+	predictionBeginCallback         PredictionBeginCallback
+	predictionProgressCallback      PredictionProgressCallback
+	predictionLockCallback          PredictionLockCallback
+	predictionEndCallback           PredictionEndCallback
+	banCallback                     BanCallback
+	unbanCallback                   UnbanCallback
+	moderatorAddCallback            ModeratorAddCallback
+	moderatorRemoveCallback         ModeratorRemoveCallback
+	goalBeginCallback               GoalBeginCallback
+	goalProgressCallback            GoalProgressCallback
+	goalEndCallback                 GoalEndCallback
+	charityCampaignDonateCallback   CharityCampaignDonateCallback
+	charityCampaignProgressCallback CharityCampaignProgressCallback
 }
 
 // NewClient allows you to get a new client that is connected to TAU
 func NewClient(hostname string, port int, token string, hasSSL bool) (*Client, error) {
+	return NewClientWithOptions(WithHost(hostname, port), WithToken(token), WithSSL(hasSSL))
+}
+
+// NewClientWithOptions allows you to get a new client that is connected to TAU, configured by the given options.
+// For example:
+//
+//	client, err := gotau.NewClientWithOptions(gotau.WithURL("wss://tau.example.com/prefix"), gotau.WithToken(token))
+func NewClientWithOptions(opts ...Option) (*Client, error) {
+	options, err := NewOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
-		hostname:           hostname,
-		port:               port,
-		hasSSL:             hasSSL,
-		token:              token,
+		hostname:           options.Hostname,
+		port:               options.Port,
+		hasSSL:             options.HasSSL,
+		token:              options.Token,
 		writeLock:          new(sync.Mutex),
 		parallelProcessing: false,
+		httpClient:         options.HTTPClient,
+		basePath:           options.BasePath,
+		dialer:             options.Dialer,
+		header:             options.Header,
+		logger:             options.Logger,
+		reconnectPolicy:    options.ReconnectPolicy,
+		keepalive:          options.Keepalive,
+		retryPolicy:        options.RetryPolicy,
 	}
-	conn, err := connect(hostname, port, hasSSL)
-	if err != nil {
-		return nil, err
+	if options.WorkerPool != nil {
+		client.SetWorkerPool(options.WorkerPool)
 	}
-	client.conn = conn
-	err = client.login()
+	err = client.Reconnect()
 	if err != nil {
+		client.SetWorkerPool(nil)
 		return nil, err
 	}
-	go client.readLoop()
 
 	return client, nil
 }
 
 // SendMessage Allows you to send json message to the server.
 func (c *Client) SendMessage(msg interface{}) error {
+	conn := c.currentConn()
+	if conn == nil {
+		if err := c.Err(); err != nil {
+			return err
+		}
+		return ErrNotConnected
+	}
 	c.writeLock.Lock()
 	defer c.writeLock.Unlock()
-	return c.conn.WriteJSON(msg)
+	_ = conn.SetWriteDeadline(c.keepaliveConfig().writeDeadline())
+	return conn.WriteJSON(msg)
 }
 
 // SetParallelProcessing Allows you to enable processing events in parallel.  By default this is false, and most people
 //probably would want it to be false, but there could be cases where processing in parallel would be useful/desirable.
+// Each message gets its own goroutine, SetWorkerPool is a bounded alternative.
 func (c *Client) SetParallelProcessing(parallel bool) {
 	c.parallelProcessing = parallel
 }
 
-// Reconnect can be used to reconnect if a connection error comes in via the ErrorCallback.
+// Reconnect can be used to reconnect if a connection error comes in via the ErrorCallback.  The current connection
+// is closed and the previous read loop is allowed to exit before a new connection is made and logged in, so only one
+// read loop is ever running.  Because of that it should not be called from event callbacks other than the
+// ErrorCallback, DisconnectCallback or ReconnectCallback unless parallel processing is enabled.
 func (c *Client) Reconnect() error {
-	if c.conn != nil {
-		_ = c.conn.Close()
+	return c.reconnect(false, 0)
+}
+
+// reconnect replaces the connection as described by Reconnect.  When onlyGeneration is set the connection is only
+// replaced if generation is still the current one, checked while holding connLock, otherwise someone else has already
+// reconnected and nil is returned without touching their connection.
+func (c *Client) reconnect(onlyGeneration bool, generation uint64) error {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
+	c.stateLock.Lock()
+	if c.err != nil {
+		c.stateLock.Unlock()
+		return c.err
+	}
+	if onlyGeneration && c.generation != generation {
+		c.stateLock.Unlock()
+		return nil
 	}
-	conn, err := connect(c.hostname, c.port, c.hasSSL)
+	old := c.conn
+	done := c.loopDone
+	c.conn = nil
+	c.stateLock.Unlock()
+
+	if old != nil {
+		_ = old.Close()
+	}
+	if done != nil {
+		<-done
+	}
+
+	conn, err := c.dial()
 	if err != nil {
+		c.logf("unable to connect to TAU: %v", err)
 		return err
 	}
+	c.stateLock.Lock()
+	if c.err != nil {
+		// closed while connecting
+		c.stateLock.Unlock()
+		_ = conn.Close()
+		return c.err
+	}
 	c.conn = conn
-	go c.readLoop()
-	return c.login()
+	c.stateLock.Unlock()
+
+	err = c.login()
+	if err != nil {
+		c.stateLock.Lock()
+		c.conn = nil
+		c.stateLock.Unlock()
+		_ = conn.Close()
+		return err
+	}
+
+	done = make(chan struct{})
+	c.stateLock.Lock()
+	c.loopDone = done
+	c.generation++
+	keepalive := c.keepalive
+	c.stateLock.Unlock()
+	c.startKeepalive(conn, keepalive, done)
+	go c.readLoop(conn, keepalive, done)
+
+	c.callbackLock.RLock()
+	connectCallback := c.connectCallback
+	c.callbackLock.RUnlock()
+	if connectCallback != nil {
+		c.safeCall(nil, connectCallback)
+	}
+	return nil
+}
+
+// Done returns a channel that is closed once the client has stopped for good, after which Err says why.  This happens
+// when the client is closed, or when the connection is lost and can't be recovered while no ErrorCallback is set.
+func (c *Client) Done() <-chan struct{} {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	if c.done == nil {
+		c.done = make(chan struct{})
+	}
+	return c.done
+}
+
+// Err returns why the client stopped, or nil if it hasn't.
+func (c *Client) Err() error {
+	c.stateLock.RLock()
+	defer c.stateLock.RUnlock()
+	return c.err
+}
+
+// terminate puts the client in its terminal state, closing the connection, the event channels and Done.  Only the
+// first error is kept.
+func (c *Client) terminate(err error) {
+	conn, _, ok := c.stop(err)
+	if !ok {
+		return
+	}
+	if conn != nil {
+		_ = conn.Close()
+	}
+	c.closeStreams()
+	c.SetWorkerPool(nil)
+}
+
+// stop records err as the reason the client stopped and closes Done, handing back the connection and the done
+// channel of its read loop so the caller can shut them down.  ok is false if the client had already stopped.
+func (c *Client) stop(err error) (conn *websocket.Conn, loopDone chan struct{}, ok bool) {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	if c.err != nil {
+		return nil, nil, false
+	}
+	c.err = err
+	if c.done == nil {
+		c.done = make(chan struct{})
+	}
+	close(c.done)
+	conn = c.conn
+	c.conn = nil
+	return conn, c.loopDone, true
+}
+
+// closeEchoTimeout is how long Shutdown waits for TAU to echo the close message before closing the connection anyway.
+const closeEchoTimeout = time.Second
+
+// Close closes the connection to TAU, waiting for any events being handled to finish.  It is the same as calling
+// Shutdown without a deadline.  Like Shutdown it must be called as go c.Close() from a callback, handler or
+// middleware.
+func (c *Client) Close() error {
+	return c.Shutdown(context.Background())
+}
+
+// Shutdown gracefully closes the connection to TAU: a close message is sent (waiting up to a second for TAU to echo
+// it), the read loop is stopped, handlers that are already running are waited on, the worker pool is retired and the
+// event channels are closed once any events already in them are received.  If ctx is done first the connection is
+// closed immediately and ctx's error is returned.  Afterwards SendMessage and
+// Reconnect return ErrClientClosed and Done is closed.  Calling it more than once is safe.
+//
+// Since it waits for the read loop and the running handlers, calling it directly from a callback, handler or
+// middleware would wait on itself forever, call it in a new goroutine instead (go c.Shutdown(ctx)).
+func (c *Client) Shutdown(ctx context.Context) error {
+	conn, loopDone, ok := c.stop(ErrClientClosed)
+	if !ok {
+		return nil
+	}
+	// nothing will be queued once the read loop stops, and the workers mustn't outlive the client if ctx is done first
+	defer c.SetWorkerPool(nil)
+	// nothing more will be published, so unblock anything waiting on a full event channel
+	c.closeStreams()
+
+	if conn != nil {
+		c.writeLock.Lock()
+		err := conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		c.writeLock.Unlock()
+		if err == nil && loopDone != nil {
+			// TAU will echo the close message back which ends the read loop, but a half open connection never will
+			timer := time.NewTimer(closeEchoTimeout)
+			select {
+			case <-loopDone:
+			case <-ctx.Done():
+			case <-timer.C:
+			}
+			timer.Stop()
+		}
+		_ = conn.Close()
+	}
+
+	if loopDone != nil {
+		select {
+		case <-loopDone:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	handlersDone := make(chan struct{})
+	go func() {
+		c.handlerWG.Wait()
+		close(handlersDone)
+	}()
+	select {
+	case <-handlersDone:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+func (c *Client) currentConn() *websocket.Conn {
+	c.stateLock.RLock()
+	defer c.stateLock.RUnlock()
+	return c.conn
 }
 
 func (c *Client) login() error {
@@ -96,37 +371,92 @@ func (c *Client) login() error {
 	return c.SendMessage(login)
 }
 
-func connect(hostname string, port int, hasSSL bool) (*websocket.Conn, error) {
-	prefix := "ws://"
-	if hasSSL {
-		prefix = "wss://"
+func (c *Client) dial() (*websocket.Conn, error) {
+	protocol := "ws"
+	if c.hasSSL {
+		protocol = "wss"
+	}
+	dialer := c.dialer
+	if dialer == nil {
+		dialer = websocket.DefaultDialer
 	}
-	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s%s:%d/ws/twitch-events/", prefix, hostname, port), nil)
+	url := fmt.Sprintf("%s/ws/twitch-events/", baseURL(protocol, c.hostname, c.port, c.basePath))
+	conn, _, err := dialer.Dial(url, c.header)
 	return conn, err
 }
 
+func connect(hostname string, port int, hasSSL bool) (*websocket.Conn, error) {
+	c := &Client{
+		hostname: hostname,
+		port:     port,
+		hasSSL:   hasSSL,
+	}
+	return c.dial()
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
 // GetAuthToken is used to get the auth token for a user to interact with TAU given a username and password.
 //Ideally this would be gathered from the UI and potentially stored in a config of some sort, but this option exists
 //in case that is not an option.
 func GetAuthToken(username, password, hostname string, port int, hasSSL bool) (string, error) {
+	return GetAuthTokenWithContext(context.Background(), username, password, hostname, port, hasSSL)
+}
+
+// GetAuthTokenWithContext is the same as GetAuthToken but takes a context to allow for cancellation and deadlines.
+func GetAuthTokenWithContext(ctx context.Context, username, password, hostname string, port int, hasSSL bool) (string, error) {
+	return GetAuthTokenWithOptions(ctx, username, password, WithHost(hostname, port), WithSSL(hasSSL))
+}
+
+// GetAuthTokenWithOptions is the same as GetAuthTokenWithContext, but TAU's location and the http client are
+// configured using options, for example when TAU is behind a reverse proxy.
+func GetAuthTokenWithOptions(ctx context.Context, username, password string, opts ...Option) (string, error) {
+	options, err := NewOptions(opts...)
+	if err != nil {
+		return "", err
+	}
 	protocol := "http"
-	if hasSSL {
+	if options.HasSSL {
 		protocol = "https"
 	}
-	url := fmt.Sprintf("%s://%s:%d/api-token-auth/", protocol, hostname, port)
+	url := fmt.Sprintf("%s/api-token-auth/", baseURL(protocol, options.Hostname, options.Port, options.BasePath))
 	body := fmt.Sprintf("{\"username\": \"%s\",\"password\": \"%s\"}", username, password)
-	/* #nosec G107 */
-	resp, err := http.Post(url, "application/json", strings.NewReader(body))
-	if err != nil {
-		return "", err
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
 	}
-	if resp.StatusCode != http.StatusOK {
-		data, _ := ioutil.ReadAll(resp.Body)
-		err := GenericError{
-			Err:  fmt.Sprintf("expected 200 status code but got %d", resp.StatusCode),
-			Body: data,
-			Code: resp.StatusCode,
+	rawData, err := options.RetryPolicy.Do(ctx, http.MethodPost, func() ([]byte, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range options.Header {
+			for _, value := range values {
+				request.Header.Add(key, value)
+			}
+		}
+		request.Header.Set("Content-Type", "application/json")
+		resp, err := httpClient.Do(request)
+		if err != nil {
+			return nil, err
 		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			data, _ := ioutil.ReadAll(resp.Body)
+			err := GenericError{
+				Err:  fmt.Sprintf("expected 200 status code but got %d", resp.StatusCode),
+				Body: data,
+				Code: resp.StatusCode,
+			}
+			return nil, err
+		}
+		return ioutil.ReadAll(resp.Body)
+	})
+	if err != nil {
 		return "", err
 	}
 
@@ -134,10 +464,6 @@ func GetAuthToken(username, password, hostname string, port int, hasSSL bool) (s
 		Token string `json:"token"`
 	}
 	data := new(tmp)
-	rawData, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
 	err = json.Unmarshal(rawData, data)
 	if err != nil {
 		return "", err
@@ -1,7 +1,9 @@
 package gotau
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestGetAuthToken_ValidRequest(t *testing.T) {
@@ -63,6 +66,14 @@ func TestClient_SetParallelProcessing(t *testing.T) {
 	require.False(t, client.parallelProcessing)
 }
 
+// closeTestConn closes a connection the test opened itself, leaving it to the garbage collector instead would make
+// the test server report a read error after the test is over.
+func closeTestConn(conn *websocket.Conn) {
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(time.Second))
+	_ = conn.Close()
+}
+
 func TestClient_login(t *testing.T) {
 	upgrader := websocket.Upgrader{}
 	wg := new(sync.WaitGroup)
@@ -76,6 +87,10 @@ func TestClient_login(t *testing.T) {
 		defer c.Close()
 		for {
 			_, message, err := c.ReadMessage()
+			_, ok := err.(*websocket.CloseError)
+			if ok {
+				return
+			}
 			if !assert.NoError(t, err) {
 				wg.Done()
 				continue
@@ -112,6 +127,7 @@ func TestClient_login(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, conn)
 	client.conn = conn
+	defer closeTestConn(conn)
 
 	wg.Add(1)
 	err = client.login()
@@ -131,6 +147,10 @@ func TestClient_SendMessage(t *testing.T) {
 		defer c.Close()
 		for {
 			_, message, err := c.ReadMessage()
+			_, ok := err.(*websocket.CloseError)
+			if ok {
+				return
+			}
 			if !assert.NoError(t, err) {
 				wg.Done()
 				continue
@@ -157,6 +177,7 @@ func TestClient_SendMessage(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, conn)
 	client.conn = conn
+	defer closeTestConn(conn)
 
 	wg.Add(1)
 	err = client.SendMessage("message")
@@ -290,3 +311,22 @@ func TestNewClient(t *testing.T) {
 	require.NotNil(t, client.conn)
 	require.Equal(t, 1, called)
 }
+
+func TestGetAuthTokenWithContext_Canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte("{\"token\": \"baz\"}"))
+	}))
+	defer server.Close()
+	url := strings.TrimPrefix(server.URL, "http://")
+	host, port, err := net.SplitHostPort(url)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	token, err := GetAuthTokenWithContext(ctx, "foo", "bar", host, portNum, false)
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Equal(t, "", token)
+}
@@ -0,0 +1,23 @@
+package gotau
+
+// UserRef identifies a twitch user, it is embedded in the messages for events that were caused by a user.
+type UserRef struct {
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+}
+
+// BroadcasterRef identifies the channel an event happened in, it is embedded in the messages for most events.
+type BroadcasterRef struct {
+	BroadcasterID    string `json:"broadcaster_user_id"`
+	BroadcasterLogin string `json:"broadcaster_user_login"`
+	BroadcasterName  string `json:"broadcaster_user_name"`
+}
+
+// Contribution is a user's contribution to a hype train, Type is bits or subscription and Total is the number of bits
+// or the value of the subscriptions.
+type Contribution struct {
+	UserRef
+	Type  ContributionType `json:"type"`
+	Total int              `json:"total"`
+}
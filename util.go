@@ -2,47 +2,123 @@ package gotau
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 )
 
 // Time wraps the standard time.Time to allow for custom parsing from json
 type Time struct {
 	time.Time
+	// layout is the layout the time was parsed with, so that it is marshalled the same way
+	layout string
 }
 
+const (
+	primaryTimeZone   = "-07:00"
+	secondaryTimeZone = "-0700"
+	// utcTimeZone is for the twitch timestamps TAU passes along in event data, which end in Z
+	utcTimeZone = "Z07:00"
+)
+
 // UnmarshalJSON implemented to allow for parsing this time object from TAU
 func (t *Time) UnmarshalJSON(b []byte) error {
-	primaryLayout := "2006-01-02T15:04:05.999999999-07:00"
-	secondaryLayout := "2006-01-02T15:04:05.999999999-0700"
+	if string(b) == "null" {
+		*t = Time{}
+		return nil
+	}
 	var timeAsString string
 	err := json.Unmarshal(b, &timeAsString)
-	timestamp, err := time.Parse(primaryLayout, timeAsString)
 	if err != nil {
-		timestamp, err = time.Parse(secondaryLayout, timeAsString)
-		if err != nil {
-			return err
+		return err
+	}
+	var timestamp time.Time
+	var zone string
+	for _, zone = range []string{primaryTimeZone, secondaryTimeZone, utcTimeZone} {
+		timestamp, err = time.Parse("2006-01-02T15:04:05.999999999"+zone, timeAsString)
+		if err == nil {
+			break
 		}
 	}
+	if err != nil {
+		return err
+	}
 	t.Time = timestamp
+	t.layout = "2006-01-02T15:04:05" + fractionLayout(timeAsString) + zone
 	return nil
 }
 
-func (c *Client) apiRequest(endpoint string, params map[string][]string, body []byte, method string) ([]byte, error) {
+// MarshalJSON writes the time the same way TAU sent it, times that weren't parsed from TAU are written with
+// microseconds, which is what TAU usually sends.  A zero time is written as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	layout := t.layout
+	if layout == "" {
+		layout = "2006-01-02T15:04:05" + primaryTimeZone
+		if t.Nanosecond() != 0 {
+			layout = "2006-01-02T15:04:05.000000" + primaryTimeZone
+		}
+	}
+	return json.Marshal(t.Format(layout))
+}
+
+// fractionLayout returns the layout for the fractional seconds in timestamp, keeping the same number of digits.
+func fractionLayout(timestamp string) string {
+	dot := strings.Index(timestamp, ".")
+	if dot < 0 {
+		return ""
+	}
+	digits := 0
+	for _, r := range timestamp[dot+1:] {
+		if r < '0' || r > '9' {
+			break
+		}
+		digits++
+	}
+	return "." + strings.Repeat("0", digits)
+}
+
+// defaultHTTPTimeout is the timeout of the http client used when one hasn't been provided.
+const defaultHTTPTimeout = 30 * time.Second
+
+var defaultHTTPClient = &http.Client{Timeout: defaultHTTPTimeout}
+
+func (c *Client) getHTTPClient() *http.Client {
+	if c.httpClient != nil {
+		return c.httpClient
+	}
+	return defaultHTTPClient
+}
+
+// apiRequest sends the request, retrying it according to the retry policy.
+func (c *Client) apiRequest(ctx context.Context, endpoint string, params map[string][]string, body []byte, method string) ([]byte, error) {
+	return c.getRetryPolicy().Do(ctx, method, func() ([]byte, error) {
+		return c.sendAPIRequest(ctx, endpoint, params, body, method)
+	})
+}
+
+func (c *Client) sendAPIRequest(ctx context.Context, endpoint string, params map[string][]string, body []byte, method string) ([]byte, error) {
 	protocol := "http"
 	if c.hasSSL {
 		protocol = "https"
 	}
-	endpointURL := fmt.Sprintf("%s://%s:%d/api/v1/%s/", protocol, c.hostname, c.port, endpoint)
-	httpClient := &http.Client{}
+	endpointURL := fmt.Sprintf("%s/api/v1/%s/", baseURL(protocol, c.hostname, c.port, c.basePath), endpoint)
 	buffer := bytes.NewBuffer(body)
-	request, err := http.NewRequest(method, endpointURL, buffer)
+	request, err := http.NewRequestWithContext(ctx, method, endpointURL, buffer)
 	if err != nil {
 		return nil, err
 	}
+	for key, values := range c.header {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
 	request.Header.Add("Authorization", fmt.Sprintf("Token %s", c.token))
 	request.Header.Add("Content-Type", "application/json")
 	_, err = request.URL.Parse(endpointURL)
@@ -59,18 +135,25 @@ func (c *Client) apiRequest(endpoint string, params map[string][]string, body []
 	}
 	request.URL.RawQuery = q.Encode()
 
-	response, err := httpClient.Do(request)
+	response, err := c.getHTTPClient().Do(request)
 	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
 	if response.StatusCode >= 200 && response.StatusCode < 300 {
 		body, err := ioutil.ReadAll(response.Body)
 		return body, err
 	}
-	if response.StatusCode == 401 {
-		return nil, AuthorizationError{}
-	}
 	body, _ = ioutil.ReadAll(response.Body)
+	if response.StatusCode == http.StatusUnauthorized {
+		message := strings.TrimSpace(string(body))
+		if message == "" {
+			message = http.StatusText(response.StatusCode)
+		}
+		return nil, AuthorizationError{
+			Err: fmt.Sprintf("response Code %d: %s (%s)", response.StatusCode, message, endpointURL),
+		}
+	}
 	err = GenericError{
 		Err:  fmt.Sprintf("response Code %d: %s", response.StatusCode, body),
 		Body: body,
@@ -46,3 +46,46 @@ func TestTime_UnmarshalJSONReturnsError(t *testing.T) {
 	err := json.Unmarshal(timeData, timestamp)
 	require.Error(t, err)
 }
+
+func TestTime_UnmarshalJSONNull(t *testing.T) {
+	type tmp struct {
+		Ts Time `json:"ts"`
+	}
+	timestamp := new(tmp)
+	err := json.Unmarshal([]byte("{\"ts\": null}"), timestamp)
+	require.NoError(t, err)
+	require.True(t, timestamp.Ts.IsZero())
+
+	err = json.Unmarshal([]byte("{\"ts\": 1621708319}"), timestamp)
+	require.Error(t, err)
+}
+
+func TestTime_MarshalJSON(t *testing.T) {
+	type tmp struct {
+		Ts Time `json:"ts"`
+	}
+	for _, data := range []string{
+		"{\"ts\":\"2021-05-22T18:31:59.588379+00:00\"}",
+		"{\"ts\":\"2021-05-22T18:31:59.588370+00:00\"}",
+		"{\"ts\":\"2021-05-22T18:31:59+00:00\"}",
+		"{\"ts\":\"2021-05-22T18:31:59+0000\"}",
+		"{\"ts\":\"2021-05-22T18:31:59.588379+0700\"}",
+		"{\"ts\":\"2020-07-15T17:16:03.17106713Z\"}",
+		"{\"ts\":null}",
+	} {
+		timestamp := new(tmp)
+		err := json.Unmarshal([]byte(data), timestamp)
+		require.NoError(t, err)
+		b, err := json.Marshal(timestamp)
+		require.NoError(t, err)
+		require.Equal(t, data, string(b))
+	}
+
+	b, err := json.Marshal(tmp{Ts: Time{Time: time.Date(2021, 5, 22, 18, 31, 59, 588379000, time.UTC)}})
+	require.NoError(t, err)
+	require.Equal(t, "{\"ts\":\"2021-05-22T18:31:59.588379+00:00\"}", string(b))
+
+	b, err = json.Marshal(tmp{Ts: Time{Time: time.Date(2021, 5, 22, 18, 31, 59, 0, time.UTC)}})
+	require.NoError(t, err)
+	require.Equal(t, "{\"ts\":\"2021-05-22T18:31:59+00:00\"}", string(b))
+}
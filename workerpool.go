@@ -0,0 +1,231 @@
+package gotau
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"sync"
+)
+
+// KeyFunc picks the ordering key for a message, messages with the same key are handled one at a time in the order
+// they were received.  event is the parsed envelope and msg is the raw message.
+type KeyFunc func(event *Event, msg []byte) string
+
+// WorkerPoolConfig configures the worker pool used to process messages, see SetWorkerPool.
+type WorkerPoolConfig struct {
+	// Workers is the number of goroutines handling messages, defaults to 1.
+	Workers int
+	// QueueSize is how many messages can be waiting to be handled before the read loop blocks.  When Key is set it is
+	// split evenly between the workers.
+	QueueSize int
+	// Key optionally keeps messages in order per key, for example KeyByBroadcaster.  Without it messages are handled
+	// by whichever worker is free, so there is no ordering between them.
+	Key KeyFunc
+}
+
+// KeyByBroadcaster keeps events for the same broadcaster in order.  Besides event_data.broadcaster_user_id it knows
+// where raids, legacy subscriptions and charity campaigns keep the broadcaster, the same as TAUEvent's Broadcaster.
+func KeyByBroadcaster(event *Event, msg []byte) string {
+	data := struct {
+		EventData struct {
+			BroadcasterID string `json:"broadcaster_user_id"`
+			// raids
+			ToBroadcasterID string `json:"to_broadcaster_user_id"`
+			// charity campaigns
+			CharityBroadcasterID string `json:"broadcaster_id"`
+			// legacy subscriptions
+			Data struct {
+				Message struct {
+					ChannelID string `json:"channel_id"`
+				} `json:"message"`
+			} `json:"data"`
+		} `json:"event_data"`
+	}{}
+	_ = json.Unmarshal(msg, &data)
+	return firstNonEmpty(data.EventData.BroadcasterID, data.EventData.ToBroadcasterID,
+		data.EventData.CharityBroadcasterID, data.EventData.Data.Message.ChannelID)
+}
+
+// KeyByUser keeps events from the same user in order.  Like TAUEvent's Actor the user is the moderator for bans and
+// unbans, the raiding broadcaster for raids, the last contributor for hype trains and the subscriber for legacy
+// subscriptions, otherwise it is event_data.user_id.
+func KeyByUser(event *Event, msg []byte) string {
+	data := struct {
+		EventData struct {
+			UserID string `json:"user_id"`
+			// bans and unbans
+			ModeratorID string `json:"moderator_user_id"`
+			// raids
+			FromBroadcasterID string `json:"from_broadcaster_user_id"`
+			// hype trains
+			LastContribution struct {
+				UserID string `json:"user_id"`
+			} `json:"last_contribution"`
+			// legacy subscriptions
+			Data struct {
+				Message struct {
+					UserID string `json:"user_id"`
+				} `json:"message"`
+			} `json:"data"`
+		} `json:"event_data"`
+	}{}
+	_ = json.Unmarshal(msg, &data)
+	return firstNonEmpty(data.EventData.ModeratorID, data.EventData.FromBroadcasterID,
+		data.EventData.LastContribution.UserID, data.EventData.UserID, data.EventData.Data.Message.UserID)
+}
+
+// firstNonEmpty returns the first of values that isn't empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+type workerPool struct {
+	queues []chan []byte
+	key    KeyFunc
+	// pending counts the messages queued or being handled
+	pending sync.WaitGroup
+	// lock guards retired so nothing is added to pending once retire is waiting on it
+	lock    sync.Mutex
+	retired bool
+	// retiring is closed when the pool is retired, stop once the workers should exit
+	retiring chan struct{}
+	stop     chan struct{}
+}
+
+// SetWorkerPool processes messages using a fixed number of workers with a bounded queue instead of the read loop,
+// applying backpressure when the queue is full.  This takes precedence over SetParallelProcessing, passing nil goes
+// back to it.  Messages already queued on a previous pool are still handled.
+func (c *Client) SetWorkerPool(config *WorkerPoolConfig) {
+	var pool *workerPool
+	if config != nil {
+		pool = newWorkerPool(c, *config)
+	}
+
+	c.poolLock.Lock()
+	old := c.pool
+	c.pool = pool
+	c.poolLock.Unlock()
+
+	if old != nil {
+		go old.retire()
+	}
+}
+
+func newWorkerPool(c *Client, config WorkerPoolConfig) *workerPool {
+	workers := config.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	queueSize := config.QueueSize
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	pool := &workerPool{
+		key:      config.Key,
+		retiring: make(chan struct{}),
+		stop:     make(chan struct{}),
+	}
+	if pool.key == nil {
+		// a single queue shared by all of the workers
+		queue := make(chan []byte, queueSize)
+		for i := 0; i < workers; i++ {
+			go pool.work(c, queue)
+		}
+		pool.queues = []chan []byte{queue}
+		return pool
+	}
+
+	for i := 0; i < workers; i++ {
+		queue := make(chan []byte, queueSize/workers)
+		go pool.work(c, queue)
+		pool.queues = append(pool.queues, queue)
+	}
+	return pool
+}
+
+// submit queues the message, blocking while the queue is full.  It gives up and returns false if the pool is retired
+// or the client stops while it is waiting.
+func (p *workerPool) submit(c *Client, msg []byte) bool {
+	queue := p.queues[0]
+	if p.key != nil {
+		event := new(Event)
+		_ = json.Unmarshal(msg, event)
+		key := ""
+		c.safeCall(msg, func() {
+			key = p.key(event, msg)
+		})
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(key))
+		queue = p.queues[hash.Sum32()%uint32(len(p.queues))]
+	}
+
+	p.lock.Lock()
+	if p.retired {
+		p.lock.Unlock()
+		return false
+	}
+	p.pending.Add(1)
+	c.handlerWG.Add(1)
+	p.lock.Unlock()
+
+	select {
+	case queue <- msg:
+		return true
+	case <-p.retiring:
+	case <-c.Done():
+	}
+	p.pending.Done()
+	c.handlerWG.Done()
+	return false
+}
+
+func (p *workerPool) work(c *Client, queue chan []byte) {
+	for {
+		select {
+		case msg := <-queue:
+			c.handleMessage(msg)
+			p.pending.Done()
+			c.handlerWG.Done()
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// retire stops the workers once everything that was queued has been handled.  No more messages can be submitted, and
+// any submit still waiting on a full queue gives up.
+func (p *workerPool) retire() {
+	p.lock.Lock()
+	p.retired = true
+	close(p.retiring)
+	p.lock.Unlock()
+
+	p.pending.Wait()
+	close(p.stop)
+}
+
+// submitMessage hands the message to the worker pool if there is one, returning false if the caller should handle it.
+// poolLock isn't held while waiting on a full queue so the pool can be replaced or retired meanwhile, in which case the
+// message goes to the new pool instead.  Once the client has stopped the message is dropped.
+func (c *Client) submitMessage(msg []byte) bool {
+	for {
+		pool := c.workerPool()
+		if pool == nil {
+			return false
+		}
+		if pool.submit(c, msg) || c.Err() != nil {
+			return true
+		}
+	}
+}
+
+func (c *Client) workerPool() *workerPool {
+	c.poolLock.RLock()
+	defer c.poolLock.RUnlock()
+	return c.pool
+}
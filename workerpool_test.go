@@ -0,0 +1,190 @@
+package gotau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testUserFollowMsg = "{\"id\":null,\"event_id\":\"69db248a-b980-4de6-ad06-b528feb1294e\",\"event_type\":\"follow\",\"event_source\":\"TestCall\",\"event_data\":{\"user_name\":\"%d\",\"user_id\":\"%s\",\"user_login\":\"finitesingularity\",\"broadcaster_user_id\":\"47073625\",\"broadcaster_user_name\":\"wwsean08\",\"broadcaster_user_login\":\"wwsean08\"},\"created\":\"2021-05-22T05:16:21.506340+00:00\",\"origin\":\"test\"}"
+
+func TestKeyFuncs(t *testing.T) {
+	msg := []byte(fmt.Sprintf(testUserFollowMsg, 1, "536397236"))
+	require.Equal(t, "47073625", KeyByBroadcaster(nil, msg))
+	require.Equal(t, "536397236", KeyByUser(nil, msg))
+	require.Empty(t, KeyByUser(nil, []byte("not json")))
+
+	raid, err := ioutil.ReadFile(filepath.Join("testdata", "events", "raid.json"))
+	require.NoError(t, err)
+	require.Equal(t, "47073625", KeyByBroadcaster(nil, raid))
+	require.Equal(t, "536397236", KeyByUser(nil, raid))
+
+	subscription, err := ioutil.ReadFile(filepath.Join("testdata", "events", "subscribe.json"))
+	require.NoError(t, err)
+	require.Equal(t, "47073625", KeyByBroadcaster(nil, subscription))
+	require.Equal(t, "536397236", KeyByUser(nil, subscription))
+
+	donation, err := ioutil.ReadFile(filepath.Join("testdata", "events", "charity-campaign-donate.json"))
+	require.NoError(t, err)
+	event := new(Event)
+	require.NoError(t, json.Unmarshal(donation, event))
+	require.Equal(t, "47073625", KeyByBroadcaster(event, donation))
+	require.Equal(t, "536397236", KeyByUser(event, donation))
+}
+
+// TestKeyFuncs_MatchAccessors checks the key funcs find the same IDs as TAUEvent's Broadcaster and Actor for every
+// sample payload.
+func TestKeyFuncs_MatchAccessors(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "events", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		msg, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		event := new(Event)
+		require.NoError(t, json.Unmarshal(msg, event))
+		tauEvent, err := decodeEvent(event.EventType, msg)
+		require.NoError(t, err)
+		if id := tauEvent.Broadcaster().BroadcasterID; id != "" {
+			require.Equal(t, id, KeyByBroadcaster(event, msg), file)
+		}
+		if id := tauEvent.Actor().UserID; id != "" {
+			require.Equal(t, id, KeyByUser(event, msg), file)
+		}
+	}
+}
+
+func TestClient_WorkerPoolKeyOrdering(t *testing.T) {
+	client := Client{}
+	lock := new(sync.Mutex)
+	received := make(map[string][]string)
+	client.OnFollow(func(msg *FollowMsg) {
+		// give other messages for the same user a chance to overtake this one if ordering is broken
+		time.Sleep(time.Millisecond)
+		lock.Lock()
+		received[msg.EventData.UserID] = append(received[msg.EventData.UserID], msg.EventData.UserName)
+		lock.Unlock()
+	})
+	client.SetWorkerPool(&WorkerPoolConfig{
+		Workers:   4,
+		QueueSize: 100,
+		Key:       KeyByUser,
+	})
+
+	var expected []string
+	for i := 0; i < 20; i++ {
+		expected = append(expected, fmt.Sprint(i))
+		for _, user := range []string{"a", "b", "c"} {
+			require.True(t, client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, i, user))))
+		}
+	}
+	client.handlerWG.Wait()
+	client.SetWorkerPool(nil)
+
+	require.Len(t, received, 3)
+	for _, names := range received {
+		require.Equal(t, expected, names)
+	}
+	require.False(t, client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 0, "a"))))
+}
+
+func TestClient_WorkerPoolConcurrent(t *testing.T) {
+	client := Client{}
+	started := new(sync.WaitGroup)
+	started.Add(2)
+	release := make(chan struct{})
+	client.OnFollow(func(msg *FollowMsg) {
+		started.Done()
+		<-release
+	})
+	client.SetWorkerPool(&WorkerPoolConfig{
+		Workers:   2,
+		QueueSize: 2,
+	})
+
+	client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 1, "a")))
+	client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 2, "a")))
+	// both handlers running at once means two workers are in use
+	started.Wait()
+	close(release)
+	client.handlerWG.Wait()
+	client.SetWorkerPool(nil)
+}
+
+func TestClient_WorkerPoolBackpressure(t *testing.T) {
+	client := Client{}
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	client.OnFollow(func(msg *FollowMsg) {
+		started <- struct{}{}
+		<-release
+	})
+	client.SetWorkerPool(&WorkerPoolConfig{
+		Workers:   1,
+		QueueSize: 1,
+	})
+
+	client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 1, "a")))
+	<-started
+	client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 2, "a")))
+
+	submitted := make(chan struct{})
+	go func() {
+		client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 3, "a")))
+		close(submitted)
+	}()
+	select {
+	case <-submitted:
+		require.Fail(t, "submit should block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-submitted
+	client.handlerWG.Wait()
+	client.SetWorkerPool(nil)
+}
+
+func TestClient_WorkerPoolShutdownWithFullQueue(t *testing.T) {
+	client := Client{}
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+	client.OnFollow(func(msg *FollowMsg) {
+		started <- struct{}{}
+		<-release
+	})
+	client.SetWorkerPool(&WorkerPoolConfig{
+		Workers:   1,
+		QueueSize: 0,
+	})
+
+	require.True(t, client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 1, "a"))))
+	<-started
+	submitted := make(chan bool)
+	go func() {
+		submitted <- client.submitMessage([]byte(fmt.Sprintf(testUserFollowMsg, 2, "a")))
+	}()
+
+	shutdown := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		shutdown <- client.Shutdown(ctx)
+	}()
+	select {
+	case err := <-shutdown:
+		require.Equal(t, context.DeadlineExceeded, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for shutdown")
+	}
+	// the stuck submit gives up, dropping the message as the client has stopped
+	require.True(t, <-submitted)
+	require.Nil(t, client.workerPool())
+}
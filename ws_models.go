@@ -4,24 +4,20 @@ import "time"
 
 // Event is the common parts of every event coming from TAU
 type Event struct {
-	ID          string `json:"id"`
-	EventID     string `json:"event_id"`
-	EventType   string `json:"event_type"`
-	EventSource string `json:"event_source"`
-	Created     Time   `json:"created"`
-	Origin      string `json:"origin"`
+	ID          string    `json:"id"`
+	EventID     string    `json:"event_id"`
+	EventType   EventType `json:"event_type"`
+	EventSource string    `json:"event_source"`
+	Created     Time      `json:"created"`
+	Origin      string    `json:"origin"`
 }
 
 // FollowMsg is a message representing a follow event that TAU sends
 type FollowMsg struct {
 	*Event
 	EventData struct {
-		UserName         string `json:"user_name"`
-		UserID           string `json:"user_id"`
-		UserLogin        string `json:"user_login"`
-		BroadcasterID    string `json:"broadcaster_user_id"`
-		BroadcasterName  string `json:"broadcaster_user_name"`
-		BroadcasterLogin string `json:"broadcaster_user_login"`
+		UserRef
+		BroadcasterRef
 	} `json:"event_data"`
 }
 
@@ -29,14 +25,12 @@ type FollowMsg struct {
 type StreamUpdateMsg struct {
 	*Event
 	EventData struct {
-		Title            string `json:"title"`
-		Language         string `json:"language"`
-		IsMature         bool   `json:"is_mature"`
-		CategoryID       int    `json:"category_id"`
-		CategoryName     string `json:"category_name"`
-		BroadcasterID    string `json:"broadcaster_user_id"`
-		BroadcasterName  string `json:"broadcaster_user_name"`
-		BroadcasterLogin string `json:"broadcaster_user_login"`
+		Title        string `json:"title"`
+		Language     string `json:"language"`
+		IsMature     bool   `json:"is_mature"`
+		CategoryID   int    `json:"category_id"`
+		CategoryName string `json:"category_name"`
+		BroadcasterRef
 	} `json:"event_data"`
 }
 
@@ -44,15 +38,11 @@ type StreamUpdateMsg struct {
 type CheerMsg struct {
 	*Event
 	EventData struct {
-		IsAnonymous      bool   `json:"is_anonymous"`
-		UserID           string `json:"user_id"`
-		UserName         string `json:"user_name"`
-		UserLogin        string `json:"user_login"`
-		BroadcasterID    string `json:"broadcaster_user_id"`
-		BroadcasterName  string `json:"broadcaster_user_name"`
-		BroadcasterLogin string `json:"broadcaster_user_login"`
-		Bits             int    `json:"bits"`
-		Message          string `json:"message"`
+		IsAnonymous bool `json:"is_anonymous"`
+		UserRef
+		BroadcasterRef
+		Bits    int    `json:"bits"`
+		Message string `json:"message"`
 	} `json:"event_data"`
 }
 
@@ -70,7 +60,8 @@ type RaidMsg struct {
 	} `json:"event_data"`
 }
 
-// SubscriptionMsg is a message that represents a subscription event that TAU sends
+// SubscriptionMsg is a message that represents a subscription event that TAU sends using the legacy PubSub format, see
+// ChannelSubscribeMsg and the other subscription messages for the EventSub format.
 type SubscriptionMsg struct {
 	*Event
 	EventData struct {
@@ -85,7 +76,7 @@ type SubscriptionMsg struct {
 				UserID             string    `json:"user_id"`
 				ChannelID          string    `json:"channel_id"`
 				Time               time.Time `json:"time"`
-				SubPlan            string    `json:"sub_plan"`
+				SubPlan            SubTier   `json:"sub_plan"`
 				SubPlanName        string    `json:"sub_plan_name"`
 				Months             int       `json:"months"`
 				CumulativeMonths   int       `json:"cumulative_months"`
@@ -110,28 +101,14 @@ type SubscriptionMsg struct {
 type HypeTrainBeginMsg struct {
 	*Event
 	EventData struct {
-		BroadcasterID    string    `json:"broadcaster_user_id"`
-		BroadcasterName  string    `json:"broadcaster_user_name"`
-		BroadcasterLogin string    `json:"broadcaster_user_login"`
-		Total            int       `json:"total"`
-		Progress         int       `json:"progress"`
-		Goal             int       `json:"goal"`
-		StartedAt        time.Time `json:"started_at"`
-		ExpiresAt        time.Time `json:"expires_at"`
-		TopContributions []struct {
-			UserID    string `json:"user_id"`
-			UserLogin string `json:"user_login"`
-			UserName  string `json:"user_name"`
-			Type      string `json:"type"`
-			Total     int    `json:"total"`
-		} `json:"top_contributions"`
-		LastContribution struct {
-			UserID    string `json:"user_id"`
-			UserLogin string `json:"user_login"`
-			UserName  string `json:"user_name"`
-			Type      string `json:"type"`
-			Total     int    `json:"total"`
-		} `json:"last_contribution"`
+		BroadcasterRef
+		Total            int            `json:"total"`
+		Progress         int            `json:"progress"`
+		Goal             int            `json:"goal"`
+		StartedAt        time.Time      `json:"started_at"`
+		ExpiresAt        time.Time      `json:"expires_at"`
+		TopContributions []Contribution `json:"top_contributions"`
+		LastContribution Contribution   `json:"last_contribution"`
 	} `json:"event_data"`
 }
 
@@ -139,29 +116,15 @@ type HypeTrainBeginMsg struct {
 type HypeTrainProgressMsg struct {
 	*Event
 	EventData struct {
-		BroadcasterID    string    `json:"broadcaster_user_id"`
-		BroadcasterName  string    `json:"broadcaster_user_name"`
-		BroadcasterLogin string    `json:"broadcaster_user_login"`
-		Level            int       `json:"level"`
-		Total            int       `json:"total"`
-		Progress         int       `json:"progress"`
-		Goal             int       `json:"goal"`
-		StartedAt        time.Time `json:"started_at"`
-		ExpiresAt        time.Time `json:"expires_at"`
-		TopContributions []struct {
-			UserID    string `json:"user_id"`
-			UserLogin string `json:"user_login"`
-			UserName  string `json:"user_name"`
-			Type      string `json:"type"`
-			Total     int    `json:"total"`
-		} `json:"top_contributions"`
-		LastContribution struct {
-			UserID    string `json:"user_id"`
-			UserLogin string `json:"user_login"`
-			UserName  string `json:"user_name"`
-			Type      string `json:"type"`
-			Total     int    `json:"total"`
-		} `json:"last_contribution"`
+		BroadcasterRef
+		Level            int            `json:"level"`
+		Total            int            `json:"total"`
+		Progress         int            `json:"progress"`
+		Goal             int            `json:"goal"`
+		StartedAt        time.Time      `json:"started_at"`
+		ExpiresAt        time.Time      `json:"expires_at"`
+		TopContributions []Contribution `json:"top_contributions"`
+		LastContribution Contribution   `json:"last_contribution"`
 	} `json:"event_data"`
 }
 
@@ -169,22 +132,14 @@ type HypeTrainProgressMsg struct {
 type HypeTrainEndedMsg struct {
 	*Event
 	EventData struct {
-		BroadcasterID    string    `json:"broadcaster_user_id"`
-		BroadcasterName  string    `json:"broadcaster_user_name"`
-		BroadcasterLogin string    `json:"broadcaster_user_login"`
-		Level            int       `json:"level"`
-		Total            int       `json:"total"`
-		Progress         int       `json:"progress"`
-		StartedAt        time.Time `json:"started_at"`
-		EndedAt          time.Time `json:"ended_at"`
-		CooldownEndsAt   time.Time `json:"cooldown_ends_at"`
-		TopContributions []struct {
-			UserID    string `json:"user_id"`
-			UserLogin string `json:"user_login"`
-			UserName  string `json:"user_name"`
-			Type      string `json:"type"`
-			Total     int    `json:"total"`
-		} `json:"top_contributions"`
+		BroadcasterRef
+		Level            int            `json:"level"`
+		Total            int            `json:"total"`
+		Progress         int            `json:"progress,omitempty"`
+		StartedAt        time.Time      `json:"started_at"`
+		EndedAt          time.Time      `json:"ended_at"`
+		CooldownEndsAt   time.Time      `json:"cooldown_ends_at"`
+		TopContributions []Contribution `json:"top_contributions"`
 	} `json:"event_data"`
 }
 
@@ -192,12 +147,10 @@ type HypeTrainEndedMsg struct {
 type StreamOnlineMsg struct {
 	*Event
 	EventData struct {
-		ID               string    `json:"id"`
-		BroadcasterID    string    `json:"broadcaster_user_id"`
-		BroadcasterName  string    `json:"broadcaster_user_name"`
-		BroadcasterLogin string    `json:"broadcaster_user_login"`
-		Type             string    `json:"type"`
-		StartedAt        time.Time `json:"started_at"`
+		ID string `json:"id"`
+		BroadcasterRef
+		Type      StreamType `json:"type"`
+		StartedAt time.Time  `json:"started_at"`
 	} `json:"event_data"`
 }
 
@@ -205,9 +158,7 @@ type StreamOnlineMsg struct {
 type StreamOfflineMsg struct {
 	*Event
 	EventData struct {
-		BroadcasterID    string `json:"broadcaster_user_id"`
-		BroadcasterName  string `json:"broadcaster_user_name"`
-		BroadcasterLogin string `json:"broadcaster_user_login"`
+		BroadcasterRef
 	} `json:"event_data"`
 }
 
@@ -215,21 +166,364 @@ type StreamOfflineMsg struct {
 type PointsRedemptionMsg struct {
 	*Event
 	EventData struct {
-		BroadcasterID    string    `json:"broadcaster_user_id"`
-		BroadcasterName  string    `json:"broadcaster_user_name"`
-		BroadcasterLogin string    `json:"broadcaster_user_login"`
-		ID               string    `json:"id"`
-		UserID           string    `json:"user_id"`
-		UserLogin        string    `json:"user_login"`
-		UserName         string    `json:"user_name"`
-		UserInput        string    `json:"user_input"`
-		Status           string    `json:"status"`
-		RedeemedAt       time.Time `json:"redeemed_at"`
-		Reward           struct {
-			ID     string `json:"id"`
-			Title  string `json:"title"`
-			Prompt string `json:"prompt"`
-			Cost   int    `json:"cost"`
-		} `json:"reward"`
+		BroadcasterRef
+		ID string `json:"id"`
+		UserRef
+		UserInput  string           `json:"user_input"`
+		Status     RedemptionStatus `json:"status"`
+		RedeemedAt time.Time        `json:"redeemed_at"`
+		Reward     RedemptionReward `json:"reward"`
+	} `json:"event_data"`
+}
+
+// ChannelSubscribeMsg is a message that represents a new subscription (not a resub) EventSub event that TAU sends
+type ChannelSubscribeMsg struct {
+	*Event
+	EventData struct {
+		UserRef
+		BroadcasterRef
+		Tier   SubTier `json:"tier"`
+		IsGift bool    `json:"is_gift"`
+	} `json:"event_data"`
+}
+
+// SubscriptionEndMsg is a message that represents a subscription ending EventSub event that TAU sends
+type SubscriptionEndMsg struct {
+	*Event
+	EventData struct {
+		UserRef
+		BroadcasterRef
+		Tier   SubTier `json:"tier"`
+		IsGift bool    `json:"is_gift"`
+	} `json:"event_data"`
+}
+
+// SubscriptionGiftMsg is a message that represents gift subscriptions EventSub event that TAU sends.  The user fields
+// are empty when the gift is anonymous, and CumulativeTotal is 0 when it is anonymous or the user doesn't share it.
+type SubscriptionGiftMsg struct {
+	*Event
+	EventData struct {
+		UserRef
+		BroadcasterRef
+		Total           int     `json:"total"`
+		Tier            SubTier `json:"tier"`
+		CumulativeTotal int     `json:"cumulative_total"`
+		IsAnonymous     bool    `json:"is_anonymous"`
+	} `json:"event_data"`
+}
+
+// SubscriptionMessageMsg is a message that represents a resub message EventSub event that TAU sends.  StreakMonths is
+// 0 when the user doesn't share it.
+type SubscriptionMessageMsg struct {
+	*Event
+	EventData struct {
+		UserRef
+		BroadcasterRef
+		Tier    SubTier `json:"tier"`
+		Message struct {
+			Text   string `json:"text"`
+			Emotes []struct {
+				Begin int    `json:"begin"`
+				End   int    `json:"end"`
+				ID    string `json:"id"`
+			} `json:"emotes"`
+		} `json:"message"`
+		CumulativeMonths int `json:"cumulative_months"`
+		StreakMonths     int `json:"streak_months"`
+		DurationMonths   int `json:"duration_months"`
+	} `json:"event_data"`
+}
+
+// RewardLimit is a cap on how many times a channel points custom reward can be redeemed
+type RewardLimit struct {
+	IsEnabled bool `json:"is_enabled"`
+	Value     int  `json:"value"`
+}
+
+// RewardCooldown is how long a channel points custom reward can't be redeemed for after being redeemed
+type RewardCooldown struct {
+	IsEnabled bool `json:"is_enabled"`
+	Seconds   int  `json:"seconds"`
+}
+
+// PointsRewardData is the event data of the channel points custom reward add, update and remove messages
+type PointsRewardData struct {
+	CustomReward
+	BroadcasterRef
+	MaxPerStream        RewardLimit    `json:"max_per_stream"`
+	MaxPerUserPerStream RewardLimit    `json:"max_per_user_per_stream"`
+	GlobalCooldown      RewardCooldown `json:"global_cooldown"`
+}
+
+// PointsRewardAddMsg is a message that represents a channel points custom reward being created that TAU sends
+type PointsRewardAddMsg struct {
+	*Event
+	EventData PointsRewardData `json:"event_data"`
+}
+
+// PointsRewardUpdateMsg is a message that represents a channel points custom reward being updated that TAU sends
+type PointsRewardUpdateMsg struct {
+	*Event
+	EventData PointsRewardData `json:"event_data"`
+}
+
+// PointsRewardRemoveMsg is a message that represents a channel points custom reward being deleted that TAU sends
+type PointsRewardRemoveMsg struct {
+	*Event
+	EventData PointsRewardData `json:"event_data"`
+}
+
+// PointsRedemptionUpdateMsg is a message that represents a points redemption being fulfilled or canceled that TAU
+// sends
+type PointsRedemptionUpdateMsg struct {
+	*Event
+	EventData struct {
+		BroadcasterRef
+		ID string `json:"id"`
+		UserRef
+		UserInput  string           `json:"user_input"`
+		Status     RedemptionStatus `json:"status"`
+		RedeemedAt time.Time        `json:"redeemed_at"`
+		Reward     RedemptionReward `json:"reward"`
+	} `json:"event_data"`
+}
+
+// PollBeginMsg is a message that represents a poll starting that TAU sends
+type PollBeginMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title               string       `json:"title"`
+		Choices             []PollChoice `json:"choices"`
+		BitsVoting          PollVoting   `json:"bits_voting"`
+		ChannelPointsVoting PollVoting   `json:"channel_points_voting"`
+		StartedAt           time.Time    `json:"started_at"`
+		EndsAt              time.Time    `json:"ends_at"`
+	} `json:"event_data"`
+}
+
+// PollProgressMsg is a message that represents votes being cast on a poll that TAU sends
+type PollProgressMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title               string       `json:"title"`
+		Choices             []PollChoice `json:"choices"`
+		BitsVoting          PollVoting   `json:"bits_voting"`
+		ChannelPointsVoting PollVoting   `json:"channel_points_voting"`
+		StartedAt           time.Time    `json:"started_at"`
+		EndsAt              time.Time    `json:"ends_at"`
+	} `json:"event_data"`
+}
+
+// PollEndMsg is a message that represents a poll ending that TAU sends, Status is completed, archived or terminated
+type PollEndMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title               string       `json:"title"`
+		Choices             []PollChoice `json:"choices"`
+		BitsVoting          PollVoting   `json:"bits_voting"`
+		ChannelPointsVoting PollVoting   `json:"channel_points_voting"`
+		Status              PollStatus   `json:"status"`
+		StartedAt           time.Time    `json:"started_at"`
+		EndedAt             time.Time    `json:"ended_at"`
+	} `json:"event_data"`
+}
+
+// PredictionBeginMsg is a message that represents a prediction starting that TAU sends
+type PredictionBeginMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title     string              `json:"title"`
+		Outcomes  []PredictionOutcome `json:"outcomes"`
+		StartedAt time.Time           `json:"started_at"`
+		LocksAt   time.Time           `json:"locks_at"`
+	} `json:"event_data"`
+}
+
+// PredictionProgressMsg is a message that represents users making predictions that TAU sends
+type PredictionProgressMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title     string              `json:"title"`
+		Outcomes  []PredictionOutcome `json:"outcomes"`
+		StartedAt time.Time           `json:"started_at"`
+		LocksAt   time.Time           `json:"locks_at"`
+	} `json:"event_data"`
+}
+
+// PredictionLockMsg is a message that represents a prediction being locked, so no more predictions can be made, that
+// TAU sends
+type PredictionLockMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title     string              `json:"title"`
+		Outcomes  []PredictionOutcome `json:"outcomes"`
+		StartedAt time.Time           `json:"started_at"`
+		LockedAt  time.Time           `json:"locked_at"`
+	} `json:"event_data"`
+}
+
+// PredictionEndMsg is a message that represents a prediction ending that TAU sends, Status is resolved or canceled and
+// WinningOutcomeID is empty if it was canceled
+type PredictionEndMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Title            string              `json:"title"`
+		WinningOutcomeID string              `json:"winning_outcome_id"`
+		Outcomes         []PredictionOutcome `json:"outcomes"`
+		Status           PredictionStatus    `json:"status"`
+		StartedAt        time.Time           `json:"started_at"`
+		EndedAt          time.Time           `json:"ended_at"`
+	} `json:"event_data"`
+}
+
+// BanMsg is a message that represents a user being banned or timed out that TAU sends, EndsAt is nil for permanent
+// bans
+type BanMsg struct {
+	*Event
+	EventData struct {
+		UserRef
+		BroadcasterRef
+		ModeratorID    string     `json:"moderator_user_id"`
+		ModeratorLogin string     `json:"moderator_user_login"`
+		ModeratorName  string     `json:"moderator_user_name"`
+		Reason         string     `json:"reason"`
+		BannedAt       time.Time  `json:"banned_at"`
+		EndsAt         *time.Time `json:"ends_at"`
+		IsPermanent    bool       `json:"is_permanent"`
+	} `json:"event_data"`
+}
+
+// UnbanMsg is a message that represents a user being unbanned that TAU sends
+type UnbanMsg struct {
+	*Event
+	EventData struct {
+		UserRef
+		BroadcasterRef
+		ModeratorID    string `json:"moderator_user_id"`
+		ModeratorLogin string `json:"moderator_user_login"`
+		ModeratorName  string `json:"moderator_user_name"`
+	} `json:"event_data"`
+}
+
+// ModeratorAddMsg is a message that represents a user being made a moderator that TAU sends
+type ModeratorAddMsg struct {
+	*Event
+	EventData struct {
+		BroadcasterRef
+		UserRef
+	} `json:"event_data"`
+}
+
+// ModeratorRemoveMsg is a message that represents a user no longer being a moderator that TAU sends
+type ModeratorRemoveMsg struct {
+	*Event
+	EventData struct {
+		BroadcasterRef
+		UserRef
+	} `json:"event_data"`
+}
+
+// GoalBeginMsg is a message that represents a creator goal starting that TAU sends, Type is the kind of goal such as
+// GoalFollow or GoalSubscription
+type GoalBeginMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Type          GoalType  `json:"type"`
+		Description   string    `json:"description"`
+		CurrentAmount int       `json:"current_amount"`
+		TargetAmount  int       `json:"target_amount"`
+		StartedAt     time.Time `json:"started_at"`
+	} `json:"event_data"`
+}
+
+// GoalProgressMsg is a message that represents progress being made towards a creator goal that TAU sends
+type GoalProgressMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Type          GoalType  `json:"type"`
+		Description   string    `json:"description"`
+		CurrentAmount int       `json:"current_amount"`
+		TargetAmount  int       `json:"target_amount"`
+		StartedAt     time.Time `json:"started_at"`
+	} `json:"event_data"`
+}
+
+// GoalEndMsg is a message that represents a creator goal ending that TAU sends
+type GoalEndMsg struct {
+	*Event
+	EventData struct {
+		ID string `json:"id"`
+		BroadcasterRef
+		Type          GoalType  `json:"type"`
+		Description   string    `json:"description"`
+		IsAchieved    bool      `json:"is_achieved"`
+		CurrentAmount int       `json:"current_amount"`
+		TargetAmount  int       `json:"target_amount"`
+		StartedAt     time.Time `json:"started_at"`
+		EndedAt       time.Time `json:"ended_at"`
+	} `json:"event_data"`
+}
+
+// CharityAmount is an amount of money in a charity campaign, Value is in the currency's minor unit so 1050 with 2
+// DecimalPlaces is 10.50
+type CharityAmount struct {
+	Value         int    `json:"value"`
+	DecimalPlaces int    `json:"decimal_places"`
+	Currency      string `json:"currency"`
+}
+
+// CharityCampaignDonateMsg is a message that represents a donation to a charity campaign that TAU sends.  Unlike most
+// events the broadcaster fields are named broadcaster_id etc rather than broadcaster_user_id, so BroadcasterRef isn't
+// used.
+type CharityCampaignDonateMsg struct {
+	*Event
+	EventData struct {
+		ID               string `json:"id"`
+		CampaignID       string `json:"campaign_id"`
+		BroadcasterID    string `json:"broadcaster_id"`
+		BroadcasterLogin string `json:"broadcaster_login"`
+		BroadcasterName  string `json:"broadcaster_name"`
+		UserRef
+		CharityName        string        `json:"charity_name"`
+		CharityDescription string        `json:"charity_description"`
+		CharityLogo        string        `json:"charity_logo"`
+		CharityWebsite     string        `json:"charity_website"`
+		Amount             CharityAmount `json:"amount"`
+	} `json:"event_data"`
+}
+
+// CharityCampaignProgressMsg is a message that represents progress being made towards a charity campaign's target
+// that TAU sends, like CharityCampaignDonateMsg its broadcaster fields don't match BroadcasterRef
+type CharityCampaignProgressMsg struct {
+	*Event
+	EventData struct {
+		ID                 string        `json:"id"`
+		BroadcasterID      string        `json:"broadcaster_id"`
+		BroadcasterLogin   string        `json:"broadcaster_login"`
+		BroadcasterName    string        `json:"broadcaster_name"`
+		CharityName        string        `json:"charity_name"`
+		CharityDescription string        `json:"charity_description"`
+		CharityLogo        string        `json:"charity_logo"`
+		CharityWebsite     string        `json:"charity_website"`
+		CurrentAmount      CharityAmount `json:"current_amount"`
+		TargetAmount       CharityAmount `json:"target_amount"`
 	} `json:"event_data"`
 }